@@ -0,0 +1,91 @@
+import { BadRequestException, NotFoundException } from '@nestjs/common';
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { prisma } from '@signalcraft/database';
+import {
+  CorrelationRulesController,
+  CreateCorrelationRuleDto,
+  UpdateCorrelationRuleDto,
+} from './correlation-rules.controller';
+
+jest.mock('@signalcraft/database', () => ({
+  prisma: {
+    correlationRule: { upsert: jest.fn(), updateMany: jest.fn(), findFirst: jest.fn() },
+  },
+}));
+
+const options = { whitelist: true, forbidNonWhitelisted: true };
+
+const errorsFor = async (dtoClass: any, body: Record<string, unknown>) =>
+  (await validate(plainToInstance(dtoClass, body), options)).map((error) => error.property);
+
+describe('CreateCorrelationRuleDto', () => {
+  const rule = { sourceGroupKey: 'db-primary-down', targetGroupKey: 'api-5xx', confidence: 0.9 };
+
+  it('accepts a rule', async () => {
+    await expect(errorsFor(CreateCorrelationRuleDto, rule)).resolves.toEqual([]);
+  });
+
+  it.each([
+    [{ ...rule, sourceGroupKey: '' }, 'sourceGroupKey'],
+    [{ ...rule, targetGroupKey: undefined }, 'targetGroupKey'],
+    [{ ...rule, confidence: '0.9' }, 'confidence'],
+    [{ ...rule, confidence: -0.1 }, 'confidence'],
+    [{ ...rule, confidence: 1.1 }, 'confidence'],
+    [{ ...rule, curated: false }, 'curated'],
+  ])('rejects %p', async (body, property) => {
+    await expect(errorsFor(CreateCorrelationRuleDto, body)).resolves.toEqual([property]);
+  });
+});
+
+describe('UpdateCorrelationRuleDto', () => {
+  it.each([{}, { confidence: 0 }, { confidence: 1 }])('accepts %p', async (body) => {
+    await expect(errorsFor(UpdateCorrelationRuleDto, body)).resolves.toEqual([]);
+  });
+
+  it.each([{ confidence: 2 }, { confidence: null }])('rejects %p', async (body) => {
+    await expect(errorsFor(UpdateCorrelationRuleDto, body)).resolves.toEqual(['confidence']);
+  });
+});
+
+describe('CorrelationRulesController', () => {
+  const controller = new CorrelationRulesController({} as any);
+  const prismaClient = prisma as any;
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+  });
+
+  it('rejects a rule from a group to itself', async () => {
+    await expect(
+      controller.createRule('ws-1', { sourceGroupKey: 'api-5xx', targetGroupKey: 'api-5xx', confidence: 0.5 }),
+    ).rejects.toBeInstanceOf(BadRequestException);
+    expect(prismaClient.correlationRule.upsert).not.toHaveBeenCalled();
+  });
+
+  it('curates a learned rule for the same pair', async () => {
+    prismaClient.correlationRule.upsert.mockResolvedValue({ id: 'rule-1' });
+
+    await controller.createRule('ws-1', { sourceGroupKey: 'db', targetGroupKey: 'api', confidence: 0.9 });
+    expect(prismaClient.correlationRule.upsert).toHaveBeenCalledWith(
+      expect.objectContaining({
+        where: {
+          workspaceId_sourceGroupKey_targetGroupKey: {
+            workspaceId: 'ws-1',
+            sourceGroupKey: 'db',
+            targetGroupKey: 'api',
+          },
+        },
+        update: expect.objectContaining({ confidence: 0.9, curated: true }),
+      }),
+    );
+  });
+
+  it('fails to update a rule of another workspace', async () => {
+    prismaClient.correlationRule.updateMany.mockResolvedValue({ count: 0 });
+
+    await expect(controller.updateRule('ws-1', 'rule-1', { confidence: 0.5 })).rejects.toBeInstanceOf(
+      NotFoundException,
+    );
+  });
+});
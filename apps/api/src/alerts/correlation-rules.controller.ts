@@ -1,10 +1,46 @@
-import { Controller, Get, Delete, Param, UseGuards, Post, Query } from '@nestjs/common';
+import {
+  BadRequestException,
+  Body,
+  Controller,
+  Get,
+  Delete,
+  NotFoundException,
+  Param,
+  UseGuards,
+  Post,
+  Put,
+  Query,
+} from '@nestjs/common';
 import { ApiTags, ApiOperation, ApiBearerAuth } from '@nestjs/swagger';
+import { IsNotEmpty, IsNumber, IsOptional, IsString, Max, Min } from 'class-validator';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { prisma } from '@signalcraft/database';
 import { CorrelationService } from './correlation.service';
 
+export class CreateCorrelationRuleDto {
+  @IsString()
+  @IsNotEmpty()
+  sourceGroupKey!: string;
+
+  @IsString()
+  @IsNotEmpty()
+  targetGroupKey!: string;
+
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  confidence!: number;
+}
+
+export class UpdateCorrelationRuleDto {
+  @IsOptional()
+  @IsNumber()
+  @Min(0)
+  @Max(1)
+  confidence?: number;
+}
+
 @ApiTags('Correlation Rules')
 @ApiBearerAuth()
 @Controller('api/correlation-rules')
@@ -54,6 +90,49 @@ export class CorrelationRulesController {
     return enrichedRules;
   }
 
+  @Post()
+  @ApiOperation({ summary: 'Create or curate a correlation rule' })
+  async createRule(@WorkspaceId() workspaceId: string, @Body() dto: CreateCorrelationRuleDto) {
+    if (dto.sourceGroupKey === dto.targetGroupKey) {
+      throw new BadRequestException('sourceGroupKey and targetGroupKey must differ');
+    }
+    // A learned rule for the same pair becomes curated
+    return prisma.correlationRule.upsert({
+      where: {
+        workspaceId_sourceGroupKey_targetGroupKey: {
+          workspaceId,
+          sourceGroupKey: dto.sourceGroupKey,
+          targetGroupKey: dto.targetGroupKey,
+        },
+      },
+      update: { confidence: dto.confidence, curated: true, lastUpdatedAt: new Date() },
+      create: {
+        workspaceId,
+        sourceGroupKey: dto.sourceGroupKey,
+        targetGroupKey: dto.targetGroupKey,
+        confidence: dto.confidence,
+        curated: true,
+      },
+    });
+  }
+
+  @Put(':id')
+  @ApiOperation({ summary: 'Update a correlation rule' })
+  async updateRule(
+    @WorkspaceId() workspaceId: string,
+    @Param('id') ruleId: string,
+    @Body() dto: UpdateCorrelationRuleDto,
+  ) {
+    const result = await prisma.correlationRule.updateMany({
+      where: { id: ruleId, workspaceId },
+      data: { confidence: dto.confidence, curated: true, lastUpdatedAt: new Date() },
+    });
+    if (result.count === 0) {
+      throw new NotFoundException('Correlation rule not found');
+    }
+    return prisma.correlationRule.findFirst({ where: { id: ruleId, workspaceId } });
+  }
+
   @Delete(':id')
   @ApiOperation({ summary: 'Delete a correlation rule' })
   async deleteRule(@WorkspaceId() workspaceId: string, @Param('id') ruleId: string) {
@@ -91,4 +170,16 @@ export class CorrelationRulesController {
       avgConfidence: Math.round(avgConfidence * 100) / 100,
     };
   }
+
+  @Get(':id')
+  @ApiOperation({ summary: 'Get a correlation rule' })
+  async getRule(@WorkspaceId() workspaceId: string, @Param('id') ruleId: string) {
+    const rule = await prisma.correlationRule.findFirst({
+      where: { id: ruleId, workspaceId },
+    });
+    if (!rule) {
+      throw new NotFoundException('Correlation rule not found');
+    }
+    return rule;
+  }
 }
@@ -115,6 +115,21 @@ export class CorrelationService {
             const confidence = count / (groupOccurrenceCounts[keyA] || count);
 
             if (confidence >= 0.5) {
+                // Curated rules are maintained by humans and never overwritten
+                const existing = await prisma.correlationRule.findUnique({
+                    where: {
+                        workspaceId_sourceGroupKey_targetGroupKey: {
+                            workspaceId,
+                            sourceGroupKey: keyA,
+                            targetGroupKey: keyB,
+                        },
+                    },
+                    select: { curated: true },
+                });
+                if (existing?.curated) {
+                    continue;
+                }
+
                 // Upsert rule
                 await prisma.correlationRule.upsert({
                     where: {
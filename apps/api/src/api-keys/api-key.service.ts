@@ -1,4 +1,4 @@
-import { Injectable, Logger } from '@nestjs/common';
+import { BadRequestException, Injectable, Logger, NotFoundException } from '@nestjs/common';
 import { prisma } from '@signalcraft/database';
 import * as crypto from 'crypto';
 
@@ -22,6 +22,15 @@ export interface ApiKeyResponse {
   serviceAccountId?: string | null;
 }
 
+/**
+ * Authenticated principal as attached to the request by the Clerk or API key
+ * guard.
+ */
+export interface RequestActor {
+  clerkId?: string;
+  apiKeyId?: string;
+}
+
 export interface ApiKeyValidationResult {
   apiKeyId: string;
   workspaceId: string;
@@ -33,11 +42,35 @@ export class ApiKeyService {
   private readonly logger = new Logger(ApiKeyService.name);
   private readonly prismaClient = prisma as any;
 
+  /**
+   * Resolve the user recorded as creator. Requests made with an API key are
+   * attributed to the user who created that key.
+   */
+  async resolveCreatorId(actor: RequestActor | undefined): Promise<string> {
+    if (actor?.clerkId) {
+      const user = await prisma.user.findUnique({ where: { clerkId: actor.clerkId } });
+      if (!user) {
+        throw new NotFoundException('User not found');
+      }
+      return user.id;
+    }
+
+    if (actor?.apiKeyId) {
+      const apiKey = await this.prismaClient.apiKey.findUnique({ where: { id: actor.apiKeyId } });
+      if (apiKey) {
+        return apiKey.createdBy;
+      }
+    }
+
+    throw new BadRequestException('Missing user context');
+  }
+
   /**
    * Generate a new API key
    * Format: sk_live_<32_random_bytes_hex>
+   * Pass a transaction client to create the key as part of a larger write.
    */
-  async createApiKey(dto: CreateApiKeyDto): Promise<ApiKeyResponse> {
+  async createApiKey(dto: CreateApiKeyDto, tx: any = this.prismaClient): Promise<ApiKeyResponse> {
     // Generate random key
     const randomBytes = crypto.randomBytes(32);
     const key = `sk_live_${randomBytes.toString('hex')}`;
@@ -48,7 +81,7 @@ export class ApiKeyService {
     // Extract prefix for display
     const prefix = key.substring(0, 16); // "sk_live_" + first 8 hex chars
 
-    const apiKey = await this.prismaClient.apiKey.create({
+    const apiKey = await tx.apiKey.create({
       data: {
         workspaceId: dto.workspaceId,
         createdBy: dto.createdBy,
@@ -0,0 +1,36 @@
+import { GUARDS_METADATA } from '@nestjs/common/constants';
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { ApiKeysController, CreateApiKeyDto } from './api-keys.controller';
+import { ROLES_KEY } from '../common/decorators/roles.decorator';
+import { RolesGuard } from '../common/guards/roles.guard';
+
+describe('ApiKeysController', () => {
+  it('runs RolesGuard on every route', () => {
+    const guards = Reflect.getMetadata(GUARDS_METADATA, ApiKeysController);
+    expect(guards).toContain(RolesGuard);
+  });
+
+  it('requires OWNER or ADMIN to create and revoke keys', () => {
+    const prototype = ApiKeysController.prototype;
+    expect(Reflect.getMetadata(ROLES_KEY, prototype.createApiKey)).toEqual(['OWNER', 'ADMIN']);
+    expect(Reflect.getMetadata(ROLES_KEY, prototype.revokeApiKey)).toEqual(['OWNER', 'ADMIN']);
+    expect(Reflect.getMetadata(ROLES_KEY, prototype.listApiKeys)).toBeUndefined();
+  });
+
+  it('accepts the create payload sent by the Terraform provider', async () => {
+    const dto = plainToInstance(CreateApiKeyDto, {
+      name: 'automation',
+      expiresAt: '2030-01-01T00:00:00Z',
+    });
+
+    await expect(validate(dto, { whitelist: true, forbidNonWhitelisted: true })).resolves.toHaveLength(0);
+  });
+
+  it('rejects a create payload without a name', async () => {
+    const dto = plainToInstance(CreateApiKeyDto, { expiresAt: 'tomorrow' });
+
+    const errors = await validate(dto);
+    expect(errors.map((error) => error.property).sort()).toEqual(['expiresAt', 'name']);
+  });
+});
@@ -8,34 +8,43 @@ import {
     UseGuards,
 } from '@nestjs/common';
 import { ApiTags, ApiOperation, ApiBearerAuth, ApiBody } from '@nestjs/swagger';
-import { ClerkAuthGuard } from '../auth/clerk-auth.guard';
+import { IsDateString, IsNotEmpty, IsOptional, IsString } from 'class-validator';
+import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
+import { RolesGuard } from '../common/guards/roles.guard';
+import { Roles } from '../common/decorators/roles.decorator';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
-import { UserId } from '../common/decorators/user-id.decorator';
-import { ApiKeyService } from './api-key.service';
+import { CurrentUser } from '../common/decorators/current-user.decorator';
+import { ApiKeyService, RequestActor } from './api-key.service';
 
-class CreateApiKeyDto {
+export class CreateApiKeyDto {
+    @IsString()
+    @IsNotEmpty()
     name!: string;
+
+    @IsOptional()
+    @IsDateString()
     expiresAt?: string; // ISO date string
 }
 
 @ApiTags('API Keys')
 @ApiBearerAuth()
 @Controller('api/api-keys')
-@UseGuards(ClerkAuthGuard)
+@UseGuards(ApiOrClerkAuthGuard, RolesGuard)
 export class ApiKeysController {
     constructor(private readonly apiKeyService: ApiKeyService) { }
 
     @Post()
+    @Roles('OWNER', 'ADMIN')
     @ApiOperation({ summary: 'Generate a new API key' })
     @ApiBody({ type: CreateApiKeyDto })
     async createApiKey(
         @WorkspaceId() workspaceId: string,
-        @UserId() userId: string,
+        @CurrentUser() user: RequestActor,
         @Body() dto: CreateApiKeyDto,
     ) {
         return this.apiKeyService.createApiKey({
             workspaceId,
-            createdBy: userId,
+            createdBy: await this.apiKeyService.resolveCreatorId(user),
             name: dto.name,
             expiresAt: dto.expiresAt ? new Date(dto.expiresAt) : undefined,
         });
@@ -48,6 +57,7 @@ export class ApiKeysController {
     }
 
     @Delete(':id')
+    @Roles('OWNER', 'ADMIN')
     @ApiOperation({ summary: 'Revoke an API key' })
     async revokeApiKey(
         @WorkspaceId() workspaceId: string,
@@ -0,0 +1,60 @@
+import { prisma } from '@signalcraft/database';
+import { resolveRequestUser } from './request-user';
+
+jest.mock('@signalcraft/database', () => ({
+  prisma: {
+    user: { findUnique: jest.fn() },
+    serviceAccount: { findUnique: jest.fn() },
+  },
+}));
+
+describe('resolveRequestUser', () => {
+  const prismaClient = prisma as any;
+  const owner = { id: 'user-1', role: 'OWNER' };
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+    prismaClient.user.findUnique.mockResolvedValue(owner);
+  });
+
+  it('returns the cached user', async () => {
+    const request = { dbUser: owner };
+
+    await expect(resolveRequestUser(request)).resolves.toBe(owner);
+    expect(prismaClient.user.findUnique).not.toHaveBeenCalled();
+  });
+
+  it('resolves a Clerk session by clerkId', async () => {
+    const request: any = { user: { clerkId: 'clerk_1' } };
+
+    await expect(resolveRequestUser(request)).resolves.toBe(owner);
+    expect(prismaClient.user.findUnique).toHaveBeenCalledWith({ where: { clerkId: 'clerk_1' } });
+    expect(request.dbUser).toBe(owner);
+  });
+
+  it('resolves a service account key through the service account creator', async () => {
+    prismaClient.serviceAccount.findUnique.mockResolvedValue({ createdBy: 'user-1' });
+
+    await resolveRequestUser({
+      user: { authType: 'apiKey', apiKeyId: 'key-1', serviceAccountId: 'sa-1' },
+    });
+
+    expect(prismaClient.serviceAccount.findUnique).toHaveBeenCalledWith({
+      where: { id: 'sa-1' },
+      select: { createdBy: true },
+    });
+    expect(prismaClient.user.findUnique).toHaveBeenCalledWith({ where: { id: 'user-1' } });
+  });
+
+  it('does not resolve a plain API key to its creator', async () => {
+    const request: any = { user: { authType: 'apiKey', apiKeyId: 'key-1' } };
+
+    await expect(resolveRequestUser(request)).resolves.toBeNull();
+    expect(prismaClient.user.findUnique).not.toHaveBeenCalled();
+    expect(request.dbUser).toBeUndefined();
+  });
+
+  it('returns null without a principal', async () => {
+    await expect(resolveRequestUser({})).resolves.toBeNull();
+  });
+});
@@ -0,0 +1,34 @@
+import { prisma, User } from '@signalcraft/database';
+
+/**
+ * Resolves the database user behind a request and caches it on
+ * `request.dbUser`. Clerk sessions map to their own user and service account
+ * keys to the user who created the service account. Other API keys have no user.
+ */
+export async function resolveRequestUser(request: any): Promise<User | null> {
+  if (request.dbUser) {
+    return request.dbUser;
+  }
+
+  const prismaClient = prisma as any;
+  const principal = request.user;
+  let user: User | null = null;
+
+  const clerkId = principal?.clerkId || principal?.clerkUserId;
+  if (clerkId) {
+    user = await prisma.user.findUnique({ where: { clerkId } });
+  } else if (principal?.serviceAccountId) {
+    const serviceAccount = await prismaClient.serviceAccount.findUnique({
+      where: { id: principal.serviceAccountId },
+      select: { createdBy: true },
+    });
+    if (serviceAccount?.createdBy) {
+      user = await prisma.user.findUnique({ where: { id: serviceAccount.createdBy } });
+    }
+  }
+
+  if (user) {
+    request.dbUser = user;
+  }
+  return user;
+}
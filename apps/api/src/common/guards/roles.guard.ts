@@ -2,7 +2,7 @@ import { CanActivate, ExecutionContext, Injectable, ForbiddenException } from '@
 import { Reflector } from '@nestjs/core';
 import { ROLES_KEY } from '../decorators/roles.decorator';
 import { WorkspaceRole } from '@signalcraft/shared';
-import { prisma } from '@signalcraft/database';
+import { resolveRequestUser } from './request-user';
 
 @Injectable()
 export class RolesGuard implements CanActivate {
@@ -19,31 +19,12 @@ export class RolesGuard implements CanActivate {
     }
 
     const request = context.switchToHttp().getRequest();
-    const prismaClient = prisma as any;
-
-    let user = request.dbUser as any;
-
-    if (!user) {
-      const clerkId = request.user?.clerkId as string | undefined;
-      if (clerkId) {
-        user = await prisma.user.findUnique({ where: { clerkId } });
-      } else if (request.user?.serviceAccountId) {
-        const serviceAccount = await prismaClient.serviceAccount.findUnique({
-          where: { id: request.user.serviceAccountId },
-          select: { createdBy: true },
-        });
-        if (serviceAccount?.createdBy) {
-          user = await prisma.user.findUnique({ where: { id: serviceAccount.createdBy } });
-        }
-      }
-    }
+    const user = await resolveRequestUser(request);
 
     if (!user) {
       throw new ForbiddenException('User not found');
     }
 
-    request.dbUser = user;
-
     if (!requiredRoles.includes(user.role as WorkspaceRole)) {
       throw new ForbiddenException('Insufficient role');
     }
@@ -0,0 +1,72 @@
+import { NotFoundException } from '@nestjs/common';
+import { prisma } from '@signalcraft/database';
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { DashboardService } from './dashboard.service';
+import { CreateDashboardDto, UpdateDashboardDto } from './dto/dashboard.dto';
+
+jest.mock('@signalcraft/database', () => ({
+    prisma: {
+        customDashboard: { findFirst: jest.fn(), delete: jest.fn() },
+    },
+}));
+
+describe('DashboardService.deleteDashboard', () => {
+    const service = new DashboardService();
+    const prismaClient = prisma as any;
+
+    beforeEach(() => {
+        jest.clearAllMocks();
+    });
+
+    it('deletes a dashboard of the workspace', async () => {
+        prismaClient.customDashboard.findFirst.mockResolvedValue({ id: 'dash-1' });
+        prismaClient.customDashboard.delete.mockResolvedValue({ id: 'dash-1' });
+
+        await service.deleteDashboard('dash-1', 'ws-1');
+
+        expect(prismaClient.customDashboard.delete).toHaveBeenCalledWith({
+            where: { id: 'dash-1', workspaceId: 'ws-1' },
+        });
+    });
+
+    it('throws NotFoundException for a missing dashboard', async () => {
+        prismaClient.customDashboard.findFirst.mockResolvedValue(null);
+
+        await expect(service.deleteDashboard('dash-1', 'ws-1')).rejects.toBeInstanceOf(NotFoundException);
+        expect(prismaClient.customDashboard.delete).not.toHaveBeenCalled();
+    });
+});
+
+describe('Dashboard DTOs', () => {
+    const options = { whitelist: true, forbidNonWhitelisted: true };
+    const widgets = [
+        {
+            id: 'open-alerts',
+            type: 'alert_count',
+            title: 'Open Alerts',
+            position: { x: 0, y: 0, w: 3, h: 2 },
+            config: { status: 'OPEN' },
+        },
+    ];
+
+    it('accepts widgets as an array', async () => {
+        const create = plainToInstance(CreateDashboardDto, {
+            name: 'SRE Overview',
+            layout: { type: 'grid', columns: 12, rows: 'auto' },
+            widgets,
+            isDefault: false,
+        });
+        const update = plainToInstance(UpdateDashboardDto, { widgets });
+
+        await expect(validate(create, options)).resolves.toHaveLength(0);
+        await expect(validate(update, options)).resolves.toHaveLength(0);
+    });
+
+    it('rejects widgets sent as an object', async () => {
+        const dto = plainToInstance(UpdateDashboardDto, { widgets: { 'open-alerts': widgets[0] } });
+
+        const errors = await validate(dto, options);
+        expect(errors.map((error) => error.property)).toEqual(['widgets']);
+    });
+});
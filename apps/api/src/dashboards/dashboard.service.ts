@@ -1,4 +1,4 @@
-import { Injectable } from '@nestjs/common';
+import { Injectable, NotFoundException } from '@nestjs/common';
 import { prisma, AlertStatus, AlertSeverity } from '@signalcraft/database';
 import { CreateDashboardDto, UpdateDashboardDto } from './dto/dashboard.dto';
 import { DASHBOARD_TEMPLATES } from './dashboard.templates';
@@ -6,7 +6,7 @@ import { DASHBOARD_TEMPLATES } from './dashboard.templates';
 @Injectable()
 export class DashboardService {
     getTemplates() {
-        return Object.values(DASHBOARD_TEMPLATES);
+        return Object.entries(DASHBOARD_TEMPLATES).map(([id, template]) => ({ id, ...template }));
     }
 
     async createDashboard(workspaceId: string, userId: string, dto: CreateDashboardDto) {
@@ -87,6 +87,13 @@ export class DashboardService {
     }
 
     async deleteDashboard(id: string, workspaceId: string) {
+        const existing = await prisma.customDashboard.findFirst({
+            where: { id, workspaceId },
+        });
+        if (!existing) {
+            throw new NotFoundException('Dashboard not found');
+        }
+
         return prisma.customDashboard.delete({
             where: { id, workspaceId },
         });
@@ -1,4 +1,4 @@
-import { IsString, IsOptional, IsBoolean, IsObject } from 'class-validator';
+import { IsString, IsOptional, IsBoolean, IsObject, IsArray } from 'class-validator';
 import { ApiProperty, ApiPropertyOptional } from '@nestjs/swagger';
 
 export class CreateDashboardDto {
@@ -16,8 +16,9 @@ export class CreateDashboardDto {
     layout!: Record<string, any>;
 
     @ApiProperty({ description: 'Array of widget definitions' })
-    @IsObject()
-    widgets!: Record<string, any>;
+    @IsArray()
+    @IsObject({ each: true })
+    widgets!: Record<string, any>[];
 
     @ApiPropertyOptional({ description: 'Set as default dashboard', default: false })
     @IsBoolean()
@@ -42,9 +43,10 @@ export class UpdateDashboardDto {
     layout?: Record<string, any>;
 
     @ApiPropertyOptional({ description: 'Widget definitions' })
-    @IsObject()
+    @IsArray()
+    @IsObject({ each: true })
     @IsOptional()
-    widgets?: Record<string, any>;
+    widgets?: Record<string, any>[];
 
     @ApiPropertyOptional({ description: 'Set as default dashboard' })
     @IsBoolean()
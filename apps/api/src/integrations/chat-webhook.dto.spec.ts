@@ -0,0 +1,42 @@
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { ConfigureDiscordDto } from './discord.controller';
+import { ConfigureTeamsDto } from './teams.controller';
+
+const options = { whitelist: true, forbidNonWhitelisted: true };
+
+const errorsFor = async (dtoClass: any, body: Record<string, unknown>) =>
+  (await validate(plainToInstance(dtoClass, body), options)).map((error) => error.property);
+
+describe('ConfigureTeamsDto', () => {
+  it('accepts an https webhook URL', async () => {
+    await expect(
+      errorsFor(ConfigureTeamsDto, { webhookUrl: 'https://example.webhook.office.com/webhookb2/abc' }),
+    ).resolves.toEqual([]);
+  });
+
+  it.each([{}, { webhookUrl: 'http://example.com/hook' }, { webhookUrl: 'not a url' }])(
+    'rejects %p',
+    async (body) => {
+      await expect(errorsFor(ConfigureTeamsDto, body)).resolves.toEqual(['webhookUrl']);
+    },
+  );
+});
+
+describe('ConfigureDiscordDto', () => {
+  it('accepts a Discord webhook URL', async () => {
+    await expect(
+      errorsFor(ConfigureDiscordDto, { webhookUrl: 'https://discord.com/api/webhooks/123/token' }),
+    ).resolves.toEqual([]);
+  });
+
+  it.each([{}, { webhookUrl: 'https://example.com/api/webhooks/123/token' }])('rejects %p', async (body) => {
+    await expect(errorsFor(ConfigureDiscordDto, body)).resolves.toEqual(['webhookUrl']);
+  });
+
+  it('rejects unknown properties', async () => {
+    await expect(
+      errorsFor(ConfigureDiscordDto, { webhookUrl: 'https://discord.com/api/webhooks/123/token', channel: 'x' }),
+    ).resolves.toEqual(['channel']);
+  });
+});
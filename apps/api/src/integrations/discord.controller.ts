@@ -1,11 +1,19 @@
-import { Body, Controller, Get, Post, UseGuards } from '@nestjs/common';
-import { ApiBearerAuth, ApiOperation, ApiTags } from '@nestjs/swagger';
+import { Body, Controller, Delete, Get, Post, UseGuards } from '@nestjs/common';
+import { ApiBearerAuth, ApiOperation, ApiProperty, ApiTags } from '@nestjs/swagger';
+import { IsNotEmpty, IsString, IsUrl, Matches } from 'class-validator';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { IntegrationsService } from './integrations.service';
 import axios from 'axios';
 
-class ConfigureDiscordDto {
+export class ConfigureDiscordDto {
+  @ApiProperty({ description: 'Discord incoming webhook URL' })
+  @IsString()
+  @IsNotEmpty()
+  @IsUrl({ protocols: ['https'], require_protocol: true })
+  @Matches(/^https:\/\/(discord|discordapp)\.com\/api\/webhooks\//, {
+    message: 'webhookUrl must be a Discord webhook URL',
+  })
   webhookUrl!: string;
 }
 
@@ -46,4 +54,11 @@ export class DiscordController {
       status: integration?.status || null,
     };
   }
+
+  @Delete()
+  @ApiOperation({ summary: 'Remove Discord integration' })
+  async remove(@WorkspaceId() workspaceId: string) {
+    await this.integrationsService.deleteIntegration(workspaceId, 'DISCORD' as any);
+    return { success: true };
+  }
 }
@@ -1,23 +1,52 @@
-import { Body, Controller, Get, Post, UseGuards } from '@nestjs/common';
+import { Body, Controller, Delete, Get, Post, UseGuards } from '@nestjs/common';
 import { ApiBearerAuth, ApiOperation, ApiTags } from '@nestjs/swagger';
+import { IsBoolean, IsEmail, IsNotEmpty, IsOptional, IsString, IsUrl } from 'class-validator';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { IntegrationsService } from './integrations.service';
 import { JiraService } from './jira.service';
 
-class ConfigureJiraDto {
+export class ConfigureJiraDto {
+  @IsOptional()
+  @IsUrl({ require_protocol: true })
   baseUrl?: string;
+
+  @IsOptional()
+  @IsEmail()
   email?: string;
+
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
   apiToken?: string;
+
+  @IsOptional()
+  @IsString()
   projectKey?: string;
+
+  @IsOptional()
+  @IsString()
   issueType?: string;
+
+  @IsOptional()
+  @IsBoolean()
   autoCreateCritical?: boolean;
+
+  // An empty token removes it
+  @IsOptional()
+  @IsString()
   webhookToken?: string;
 }
 
-class TestJiraDto {
+export class TestJiraDto {
+  @IsUrl({ require_protocol: true })
   baseUrl!: string;
+
+  @IsEmail()
   email!: string;
+
+  @IsString()
+  @IsNotEmpty()
   apiToken!: string;
 }
 
@@ -88,4 +117,11 @@ export class JiraController {
       oauthConnected: Boolean(oauth?.accessToken),
     };
   }
+
+  @Delete()
+  @ApiOperation({ summary: 'Remove Jira integration' })
+  async remove(@WorkspaceId() workspaceId: string) {
+    await this.integrationsService.deleteIntegration(workspaceId, 'JIRA' as any);
+    return { success: true };
+  }
 }
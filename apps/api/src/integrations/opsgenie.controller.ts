@@ -1,18 +1,51 @@
-import { Controller, Get, Post, Body, Query, UseGuards } from '@nestjs/common';
+import { Controller, Delete, Get, Post, Body, Query, UseGuards } from '@nestjs/common';
 import { ApiBearerAuth, ApiTags, ApiOperation } from '@nestjs/swagger';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { OpsgenieService } from './opsgenie.service';
-import { prisma } from '@signalcraft/database';
+import { AlertSeverity, prisma } from '@signalcraft/database';
+import { Type } from 'class-transformer';
+import {
+  IsArray,
+  IsIn,
+  IsNotEmpty,
+  IsOptional,
+  IsString,
+  ValidateNested,
+} from 'class-validator';
 
-class TestOpsgenieDto {
+export class TestOpsgenieDto {
+  @IsString()
+  @IsNotEmpty()
   apiKey!: string;
+
+  @IsOptional()
+  @IsIn(['us', 'eu'])
   region?: 'us' | 'eu';
 }
 
-class ConfigureOpsgenieDto {
+export class OpsgeniePriorityMappingDto {
+  @IsIn(Object.values(AlertSeverity))
+  severity!: string;
+
+  @IsIn(['P1', 'P2', 'P3', 'P4', 'P5'])
+  priority!: 'P1' | 'P2' | 'P3' | 'P4' | 'P5';
+}
+
+export class ConfigureOpsgenieDto {
+  @IsString()
+  @IsNotEmpty()
   apiKey!: string;
+
+  @IsOptional()
+  @IsIn(['us', 'eu'])
   region?: 'us' | 'eu';
+
+  @IsOptional()
+  @IsArray()
+  @ValidateNested({ each: true })
+  @Type(() => OpsgeniePriorityMappingDto)
+  priorityMappings?: OpsgeniePriorityMappingDto[];
 }
 
 @ApiTags('integrations')
@@ -31,6 +64,11 @@ export class OpsgenieController {
   @Post('configure')
   @ApiOperation({ summary: 'Configure Opsgenie integration' })
   async configure(@WorkspaceId() workspaceId: string, @Body() dto: ConfigureOpsgenieDto) {
+    const configJson = {
+      apiKey: dto.apiKey,
+      region: dto.region || 'us',
+      priorityMappings: (dto.priorityMappings ?? []) as any,
+    };
     await prisma.integration.upsert({
       where: {
         workspaceId_type: { workspaceId, type: 'OPSGENIE' as any },
@@ -39,10 +77,10 @@ export class OpsgenieController {
         workspaceId,
         type: 'OPSGENIE' as any,
         status: 'ACTIVE',
-        configJson: { apiKey: dto.apiKey, region: dto.region || 'us' },
+        configJson,
       },
       update: {
-        configJson: { apiKey: dto.apiKey, region: dto.region || 'us' },
+        configJson,
         status: 'ACTIVE',
       },
     });
@@ -55,9 +93,21 @@ export class OpsgenieController {
     const integration = await prisma.integration.findFirst({
       where: { workspaceId, type: 'OPSGENIE' as any },
     });
+    const config = (integration?.configJson ?? {}) as Record<string, unknown>;
     return {
       configured: !!integration,
       status: integration?.status || null,
+      region: config.region ?? null,
+      priorityMappings: config.priorityMappings ?? [],
     };
   }
+
+  @Delete()
+  @ApiOperation({ summary: 'Remove Opsgenie integration' })
+  async remove(@WorkspaceId() workspaceId: string) {
+    await prisma.integration.deleteMany({
+      where: { workspaceId, type: 'OPSGENIE' as any },
+    });
+    return { success: true };
+  }
 }
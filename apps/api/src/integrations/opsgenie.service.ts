@@ -2,9 +2,17 @@ import { Injectable, Logger } from '@nestjs/common';
 import { prisma } from '@signalcraft/database';
 import axios from 'axios';
 
+type OpsgeniePriority = 'P1' | 'P2' | 'P3' | 'P4' | 'P5';
+
+interface OpsgeniePriorityMapping {
+  severity: string;
+  priority: OpsgeniePriority;
+}
+
 interface OpsgenieConfig {
   apiKey: string;
   region?: 'us' | 'eu';
+  priorityMappings?: OpsgeniePriorityMapping[];
 }
 
 interface CreateAlertDto {
@@ -85,10 +93,15 @@ export class OpsgenieService {
       return { success: false, error: 'Alert not found' };
     }
 
+    const integration = await prisma.integration.findFirst({
+      where: { workspaceId, type: 'OPSGENIE' as any },
+    });
+    const config = integration?.configJson as unknown as OpsgenieConfig | undefined;
+
     return this.createAlert(workspaceId, {
       message: alert.title,
       description: `SignalCraft alert ${alert.id} - ${alert.title}`,
-      priority: this.mapToPriority(alert.severity),
+      priority: this.mapToPriority(alert.severity, config?.priorityMappings),
       alertId: alert.id,
       source: 'SignalCraft',
     });
@@ -157,9 +170,15 @@ export class OpsgenieService {
   }
 
   /**
-   * Map SignalCraft severity to Opsgenie priority
+   * Map SignalCraft severity to Opsgenie priority, preferring the workspace's
+   * configured mappings
    */
-  mapToPriority(severity: string): 'P1' | 'P2' | 'P3' | 'P4' | 'P5' {
+  mapToPriority(severity: string, mappings: OpsgeniePriorityMapping[] = []): OpsgeniePriority {
+    const mapping = mappings.find((m) => m.severity === severity.toUpperCase());
+    if (mapping) {
+      return mapping.priority;
+    }
+
     switch (severity.toUpperCase()) {
       case 'CRITICAL':
         return 'P1';
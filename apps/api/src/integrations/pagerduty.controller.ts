@@ -1,18 +1,58 @@
-import { Controller, Get, Post, Body, Query, UseGuards } from '@nestjs/common';
+import { Controller, Delete, Get, Post, Body, Query, UseGuards } from '@nestjs/common';
 import { ApiBearerAuth, ApiTags, ApiOperation } from '@nestjs/swagger';
+import { Type } from 'class-transformer';
+import {
+  IsArray,
+  IsIn,
+  IsNotEmpty,
+  IsOptional,
+  IsString,
+  ValidateNested,
+} from 'class-validator';
+import { AlertSeverity } from '@signalcraft/database';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { PagerDutyService } from './pagerduty.service';
 import { IntegrationsService } from './integrations.service';
 
-class TestPagerDutyDto {
+export class TestPagerDutyDto {
+  @IsString()
+  @IsNotEmpty()
   apiKey!: string;
+
+  @IsString()
+  @IsNotEmpty()
   serviceId!: string;
 }
 
-class ConfigurePagerDutyDto {
+export class PagerDutySeverityMappingDto {
+  @IsIn(Object.values(AlertSeverity))
+  severity!: string;
+
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
+  serviceId?: string;
+
+  @IsOptional()
+  @IsIn(['high', 'low'])
+  urgency?: 'high' | 'low';
+}
+
+export class ConfigurePagerDutyDto {
+  @IsString()
+  @IsNotEmpty()
   apiKey!: string;
+
+  @IsString()
+  @IsNotEmpty()
   serviceId!: string;
+
+  @IsOptional()
+  @IsArray()
+  @ValidateNested({ each: true })
+  @Type(() => PagerDutySeverityMappingDto)
+  severityMappings?: PagerDutySeverityMappingDto[];
 }
 
 @ApiTags('integrations')
@@ -45,6 +85,7 @@ export class PagerDutyController {
     await this.integrationsService.upsertIntegration(workspaceId, 'PAGERDUTY' as any, {
       apiKey: dto.apiKey,
       serviceId: dto.serviceId,
+      severityMappings: (dto.severityMappings ?? []) as any,
     });
 
     return { success: true };
@@ -57,9 +98,19 @@ export class PagerDutyController {
       workspaceId,
       'PAGERDUTY' as any,
     );
+    const config = (integration?.configJson ?? {}) as Record<string, unknown>;
     return {
       configured: !!integration,
       status: integration?.status || null,
+      serviceId: config.serviceId ?? null,
+      severityMappings: config.severityMappings ?? [],
     };
   }
+
+  @Delete()
+  @ApiOperation({ summary: 'Remove PagerDuty integration' })
+  async remove(@WorkspaceId() workspaceId: string) {
+    await this.integrationsService.deleteIntegration(workspaceId, 'PAGERDUTY' as any);
+    return { success: true };
+  }
 }
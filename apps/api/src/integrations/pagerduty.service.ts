@@ -2,9 +2,16 @@ import { Injectable, Logger } from '@nestjs/common';
 import { prisma } from '@signalcraft/database';
 import axios from 'axios';
 
+interface PagerDutySeverityMapping {
+  severity: string;
+  serviceId?: string;
+  urgency?: 'high' | 'low';
+}
+
 interface PagerDutyConfig {
   apiKey: string;
   serviceId: string; // Default service for incidents
+  severityMappings?: PagerDutySeverityMapping[];
 }
 
 interface CreateIncidentDto {
@@ -41,12 +48,16 @@ export class PagerDutyService {
         return { success: false, error: 'PagerDuty not configured' };
       }
 
-      const config = integration.configJson as any;
+      const config = integration.configJson as unknown as PagerDutyConfig;
       const apiKey = config.apiKey;
-      const serviceId = config.serviceId;
 
-      // Map severity to urgency
-      const urgency = ['CRITICAL', 'HIGH'].includes(dto.severity) ? 'high' : 'low';
+      // A severity mapping can route to another service and override urgency
+      const mapping = config.severityMappings?.find(
+        (m) => m.severity === dto.severity.toUpperCase(),
+      );
+      const serviceId = mapping?.serviceId || config.serviceId;
+      const urgency =
+        mapping?.urgency ?? (['CRITICAL', 'HIGH'].includes(dto.severity) ? 'high' : 'low');
 
       // Create incident via PagerDuty Events API v2
       const response = await axios.post(
@@ -0,0 +1,77 @@
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { ConfigureJiraDto } from './jira.controller';
+import { ConfigureOpsgenieDto } from './opsgenie.controller';
+import { ConfigurePagerDutyDto } from './pagerduty.controller';
+
+const options = { whitelist: true, forbidNonWhitelisted: true };
+
+const errorsFor = async (dtoClass: any, body: Record<string, unknown>) =>
+  (await validate(plainToInstance(dtoClass, body), options)).map((error) => error.property);
+
+describe('ConfigurePagerDutyDto', () => {
+  it('accepts the payload sent by the Terraform provider', async () => {
+    await expect(
+      errorsFor(ConfigurePagerDutyDto, {
+        apiKey: 'pd-key',
+        serviceId: 'PSERVICE',
+        severityMappings: [
+          { severity: 'CRITICAL', serviceId: 'PCRIT', urgency: 'high' },
+          { severity: 'LOW', urgency: 'low' },
+        ],
+      }),
+    ).resolves.toEqual([]);
+  });
+
+  it.each([
+    [{ severity: 'URGENT' }],
+    [{ severity: 'HIGH', urgency: 'medium' }],
+    [{ severity: 'HIGH', escalationPolicy: 'P1' }],
+  ])('rejects the mapping %p', async (mapping) => {
+    await expect(
+      errorsFor(ConfigurePagerDutyDto, { apiKey: 'pd-key', serviceId: 'PSERVICE', severityMappings: [mapping] }),
+    ).resolves.toEqual(['severityMappings']);
+  });
+
+  it('requires the credentials', async () => {
+    await expect(errorsFor(ConfigurePagerDutyDto, {})).resolves.toEqual(['apiKey', 'serviceId']);
+  });
+});
+
+describe('ConfigureOpsgenieDto', () => {
+  it('accepts the payload sent by the Terraform provider', async () => {
+    await expect(
+      errorsFor(ConfigureOpsgenieDto, {
+        apiKey: 'og-key',
+        region: 'eu',
+        priorityMappings: [{ severity: 'CRITICAL', priority: 'P1' }],
+      }),
+    ).resolves.toEqual([]);
+  });
+
+  it('rejects an unknown priority or region', async () => {
+    await expect(
+      errorsFor(ConfigureOpsgenieDto, {
+        apiKey: 'og-key',
+        region: 'ap',
+        priorityMappings: [{ severity: 'CRITICAL', priority: 'P0' }],
+      }),
+    ).resolves.toEqual(['region', 'priorityMappings']);
+  });
+});
+
+describe('ConfigureJiraDto', () => {
+  it('accepts the payload sent by the Terraform provider', async () => {
+    await expect(
+      errorsFor(ConfigureJiraDto, {
+        baseUrl: 'https://example.atlassian.net',
+        email: 'bot@example.com',
+        apiToken: 'token',
+        projectKey: 'OPS',
+        issueType: 'Bug',
+        autoCreateCritical: true,
+        webhookToken: '',
+      }),
+    ).resolves.toEqual([]);
+  });
+});
@@ -1,4 +1,13 @@
-import { Controller, Get, Post, Body, Delete, UseGuards, Logger } from '@nestjs/common';
+import {
+  Controller,
+  Get,
+  Post,
+  Body,
+  Delete,
+  UseGuards,
+  Logger,
+  BadRequestException,
+} from '@nestjs/common';
 import { ApiTags, ApiBearerAuth, ApiOperation } from '@nestjs/swagger';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
@@ -29,10 +38,27 @@ export class SlackController {
 
     return {
       connected: isConnected,
+      status: integration?.status ?? null,
       defaultChannel,
     };
   }
 
+  @Post('default-channel')
+  @ApiOperation({ summary: 'Set the default Slack channel' })
+  async setDefaultChannel(
+    @WorkspaceId() workspaceId: string,
+    @Body() payload: { channelId: string },
+  ) {
+    if (!payload.channelId) {
+      throw new BadRequestException('Missing channelId');
+    }
+    const updated = await this.slackService.updateDefaultChannel(workspaceId, payload.channelId);
+    if (!updated) {
+      throw new BadRequestException('Slack is not connected');
+    }
+    return { success: true };
+  }
+
   @Get('channels')
   @ApiOperation({ summary: 'List available Slack channels' })
   async listChannels(@WorkspaceId() workspaceId: string) {
@@ -1,11 +1,16 @@
-import { Body, Controller, Get, Post, UseGuards } from '@nestjs/common';
-import { ApiBearerAuth, ApiOperation, ApiTags } from '@nestjs/swagger';
+import { Body, Controller, Delete, Get, Post, UseGuards } from '@nestjs/common';
+import { ApiBearerAuth, ApiOperation, ApiProperty, ApiTags } from '@nestjs/swagger';
+import { IsNotEmpty, IsString, IsUrl } from 'class-validator';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { IntegrationsService } from './integrations.service';
 import axios from 'axios';
 
-class ConfigureTeamsDto {
+export class ConfigureTeamsDto {
+  @ApiProperty({ description: 'Microsoft Teams incoming webhook URL' })
+  @IsString()
+  @IsNotEmpty()
+  @IsUrl({ protocols: ['https'], require_protocol: true })
   webhookUrl!: string;
 }
 
@@ -48,4 +53,11 @@ export class TeamsController {
       status: integration?.status || null,
     };
   }
+
+  @Delete()
+  @ApiOperation({ summary: 'Remove Microsoft Teams integration' })
+  async remove(@WorkspaceId() workspaceId: string) {
+    await this.integrationsService.deleteIntegration(workspaceId, 'TEAMS' as any);
+    return { success: true };
+  }
 }
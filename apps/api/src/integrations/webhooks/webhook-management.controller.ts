@@ -10,15 +10,21 @@ import {
     Req,
 } from '@nestjs/common';
 import { ApiBearerAuth, ApiTags, ApiOperation } from '@nestjs/swagger';
-import { ClerkAuthGuard } from '../../auth/clerk-auth.guard';
-import { PermissionsGuard, RequirePermission, RESOURCES } from '../../permissions/permissions.guard';
+import { ApiOrClerkAuthGuard } from '../../auth/api-or-clerk-auth.guard';
+import {
+    AllowApiKey,
+    PermissionsGuard,
+    RequirePermission,
+    RESOURCES,
+} from '../../permissions/permissions.guard';
 import { WebhookRegistryService } from './webhook-registry.service';
 import { IntegrationType } from '@signalcraft/database';
 import { WorkspaceId } from '../../common/decorators/workspace-id.decorator';
 
 @ApiTags('Integrations')
 @ApiBearerAuth()
-@UseGuards(ClerkAuthGuard, PermissionsGuard)
+@UseGuards(ApiOrClerkAuthGuard, PermissionsGuard)
+@AllowApiKey()
 @Controller('api/integrations/webhooks')
 export class WebhookManagementController {
     constructor(private readonly registryService: WebhookRegistryService) { }
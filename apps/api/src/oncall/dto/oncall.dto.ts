@@ -124,9 +124,10 @@ export class UpdateOverrideDto {
   @IsDateString()
   endsAt?: string;
 
+  // null clears the reason; omitting it keeps the current one
   @IsOptional()
   @IsString()
-  reason?: string;
+  reason?: string | null;
 }
 
 export class ListOverridesDto {
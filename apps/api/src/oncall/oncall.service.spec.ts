@@ -0,0 +1,52 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { prisma } from '@signalcraft/database';
+import { OnCallService } from './oncall.service';
+import { AuditService } from '../audit/audit.service';
+
+jest.mock('@signalcraft/database', () => ({
+  prisma: {
+    onCallRotation: { findFirst: jest.fn() },
+    onCallOverride: { findFirst: jest.fn(), update: jest.fn() },
+    user: { findFirst: jest.fn() },
+  },
+}));
+
+describe('OnCallService overrides', () => {
+  let service: OnCallService;
+  const prismaClient = prisma as any;
+  const actor: any = { id: 'user-1' };
+
+  beforeEach(async () => {
+    const module: TestingModule = await Test.createTestingModule({
+      providers: [OnCallService, { provide: AuditService, useValue: { log: jest.fn() } }],
+    }).compile();
+
+    service = module.get<OnCallService>(OnCallService);
+    jest.clearAllMocks();
+
+    prismaClient.onCallRotation.findFirst.mockResolvedValue({ id: 'rot-1' });
+    prismaClient.onCallOverride.findFirst.mockResolvedValue({
+      id: 'ovr-1',
+      startsAt: new Date('2030-01-01T00:00:00Z'),
+      endsAt: new Date('2030-01-02T00:00:00Z'),
+      reason: 'Vacation cover',
+    });
+    prismaClient.onCallOverride.update.mockResolvedValue({ id: 'ovr-1', userId: 'user-1' });
+  });
+
+  it('clears the reason when null is sent', async () => {
+    await service.updateOverride('ws-1', 'rot-1', 'ovr-1', { reason: null }, actor);
+
+    expect(prismaClient.onCallOverride.update).toHaveBeenCalledWith(
+      expect.objectContaining({ data: expect.objectContaining({ reason: null }) }),
+    );
+  });
+
+  it('keeps the reason when it is omitted', async () => {
+    await service.updateOverride('ws-1', 'rot-1', 'ovr-1', {}, actor);
+
+    expect(prismaClient.onCallOverride.update).toHaveBeenCalledWith(
+      expect.objectContaining({ data: expect.objectContaining({ reason: undefined }) }),
+    );
+  });
+});
@@ -452,7 +452,7 @@ export class OnCallService {
         userId: dto.userId ?? undefined,
         startsAt: dto.startsAt ? startsAt : undefined,
         endsAt: dto.endsAt ? endsAt : undefined,
-        reason: dto.reason ?? undefined,
+        reason: dto.reason,
       },
       include: {
         user: { select: { id: true, email: true, displayName: true } },
@@ -72,7 +72,7 @@ export class UpdatePagingPolicyDto {
 
   @IsOptional()
   @IsString()
-  description?: string;
+  description?: string | null;
 
   @IsOptional()
   @IsBoolean()
@@ -0,0 +1,49 @@
+import { Test, TestingModule } from '@nestjs/testing';
+import { prisma } from '@signalcraft/database';
+import { PagingService } from './paging.service';
+import { QueueService } from '../queues/queue.service';
+import { AuditService } from '../audit/audit.service';
+
+jest.mock('@signalcraft/database', () => ({
+  prisma: {
+    pagingPolicy: { findFirst: jest.fn(), update: jest.fn() },
+  },
+}));
+
+describe('PagingService.updatePolicy', () => {
+  let service: PagingService;
+  const prismaClient = prisma as any;
+  const actor: any = { id: 'user-1' };
+
+  beforeEach(async () => {
+    const module: TestingModule = await Test.createTestingModule({
+      providers: [
+        PagingService,
+        { provide: QueueService, useValue: {} },
+        { provide: AuditService, useValue: { log: jest.fn() } },
+      ],
+    }).compile();
+
+    service = module.get<PagingService>(PagingService);
+    jest.clearAllMocks();
+
+    prismaClient.pagingPolicy.findFirst.mockResolvedValue({ id: 'pol-1', description: 'Old' });
+    prismaClient.pagingPolicy.update.mockResolvedValue({ id: 'pol-1', steps: [] });
+  });
+
+  it('clears the description when null is sent', async () => {
+    await service.updatePolicy('ws-1', 'pol-1', { description: null }, actor);
+
+    expect(prismaClient.pagingPolicy.update).toHaveBeenCalledWith(
+      expect.objectContaining({ data: expect.objectContaining({ description: null }) }),
+    );
+  });
+
+  it('keeps the description when it is omitted', async () => {
+    await service.updatePolicy('ws-1', 'pol-1', {}, actor);
+
+    expect(prismaClient.pagingPolicy.update).toHaveBeenCalledWith(
+      expect.objectContaining({ data: expect.objectContaining({ description: undefined }) }),
+    );
+  });
+});
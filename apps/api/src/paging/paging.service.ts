@@ -90,7 +90,8 @@ export class PagingService {
       where: { id: policyId },
       data: {
         name: dto.name?.trim() ?? undefined,
-        description: dto.description?.trim() ?? undefined,
+        // null clears the description; omitting it keeps the current one
+        description: dto.description === undefined ? undefined : dto.description?.trim() || null,
         rotationId: dto.rotationId ?? undefined,
         enabled: dto.enabled ?? undefined,
         steps: dto.steps
@@ -0,0 +1,58 @@
+import { ExecutionContext } from '@nestjs/common';
+import { ROUTE_ARGS_METADATA } from '@nestjs/common/constants';
+import { PermissionsController } from './permissions.controller';
+import { PermissionsService } from './permissions.service';
+
+describe('PermissionsController', () => {
+  const permissionsService = {
+    getPermissionsForRole: jest.fn(),
+    updateRolePermissions: jest.fn(),
+  } as unknown as PermissionsService;
+  const controller = new PermissionsController(permissionsService);
+
+  // Runs the custom parameter decorator at the given position of a handler.
+  const resolveParam = (handler: string, index: number, request: any) => {
+    const args = Reflect.getMetadata(ROUTE_ARGS_METADATA, PermissionsController, handler);
+    const param: any = Object.values(args).find((arg: any) => arg.index === index && arg.factory);
+    const context = {
+      switchToHttp: () => ({ getRequest: () => request }),
+    } as unknown as ExecutionContext;
+    return param.factory(param.data, context);
+  };
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+  });
+
+  it.each(['getRolePermissions', 'updateRolePermissions'])(
+    'resolves the workspace of an API key for %s',
+    async (handler) => {
+      const request = { user: { authType: 'apiKey', workspaceId: 'ws-1', apiKeyId: 'key-1' } };
+
+      await expect(resolveParam(handler, 0, request)).resolves.toBe('ws-1');
+    },
+  );
+
+  it('reads the permissions of a role in the workspace', async () => {
+    (permissionsService.getPermissionsForRole as jest.Mock).mockResolvedValue({ alerts: ['READ'] });
+
+    await expect(controller.getRolePermissions('ws-1', 'MEMBER' as any)).resolves.toEqual({ alerts: ['READ'] });
+    expect(permissionsService.getPermissionsForRole).toHaveBeenCalledWith('ws-1', 'MEMBER');
+  });
+
+  it('updates the permissions of a role in the workspace', async () => {
+    (permissionsService.updateRolePermissions as jest.Mock).mockResolvedValue({ alerts: ['READ'] });
+
+    await expect(
+      controller.updateRolePermissions('ws-1', 'MEMBER' as any, { permissions: { alerts: ['READ'] as any } }),
+    ).resolves.toEqual({ success: true, permissions: { alerts: ['READ'] } });
+    expect(permissionsService.updateRolePermissions).toHaveBeenCalledWith('ws-1', 'MEMBER', { alerts: ['READ'] });
+  });
+
+  it('refuses to change OWNER permissions', async () => {
+    await expect(
+      controller.updateRolePermissions('ws-1', 'OWNER' as any, { permissions: {} }),
+    ).resolves.toEqual({ error: 'Cannot modify OWNER permissions', status: 403 });
+    expect(permissionsService.updateRolePermissions).not.toHaveBeenCalled();
+  });
+});
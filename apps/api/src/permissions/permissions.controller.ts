@@ -4,6 +4,7 @@ import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { PermissionsService, RESOURCES, ResourceName } from './permissions.service';
 import { RequirePermission, PermissionsGuard } from './permissions.guard';
 import { WorkspaceRole, PermissionAction } from '@signalcraft/database';
+import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 
 interface AuthenticatedRequest extends Request {
   auth?: {
@@ -46,12 +47,7 @@ export class PermissionsController {
   @Get('role/:role')
   @UseGuards(PermissionsGuard)
   @RequirePermission(RESOURCES.SETTINGS, 'READ')
-  async getRolePermissions(@Req() req: AuthenticatedRequest, @Param('role') role: WorkspaceRole) {
-    const workspaceId = req.auth?.workspaceId;
-    if (!workspaceId) {
-      return { error: 'Workspace not found', status: 404 };
-    }
-
+  async getRolePermissions(@WorkspaceId() workspaceId: string, @Param('role') role: WorkspaceRole) {
     return this.permissionsService.getPermissionsForRole(workspaceId, role);
   }
 
@@ -62,15 +58,10 @@ export class PermissionsController {
   @UseGuards(PermissionsGuard)
   @RequirePermission(RESOURCES.SETTINGS, 'MANAGE')
   async updateRolePermissions(
-    @Req() req: AuthenticatedRequest,
+    @WorkspaceId() workspaceId: string,
     @Param('role') role: WorkspaceRole,
     @Body() body: UpdatePermissionsDto,
   ) {
-    const workspaceId = req.auth?.workspaceId;
-    if (!workspaceId) {
-      return { error: 'Workspace not found', status: 404 };
-    }
-
     // Prevent modifying OWNER permissions to avoid lockout
     if (role === 'OWNER') {
       return { error: 'Cannot modify OWNER permissions', status: 403 };
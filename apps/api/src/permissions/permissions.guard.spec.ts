@@ -0,0 +1,104 @@
+import { ExecutionContext, ForbiddenException } from '@nestjs/common';
+import { Reflector } from '@nestjs/core';
+import { ALLOW_API_KEY_KEY, PERMISSION_KEY, PermissionsGuard } from './permissions.guard';
+import { PermissionsService } from './permissions.service';
+import { resolveRequestUser } from '../common/guards/request-user';
+
+jest.mock('../common/guards/request-user', () => ({
+  resolveRequestUser: jest.fn(),
+}));
+
+describe('PermissionsGuard', () => {
+  const reflector = { getAllAndOverride: jest.fn() } as unknown as Reflector;
+  const permissionsService = {
+    checkPermission: jest.fn(),
+  } as unknown as PermissionsService;
+  const guard = new PermissionsGuard(reflector, permissionsService);
+
+  const contextFor = (request: any) =>
+    ({
+      getHandler: () => undefined,
+      getClass: () => undefined,
+      switchToHttp: () => ({ getRequest: () => request }),
+    }) as unknown as ExecutionContext;
+
+  const withMetadata = (metadata: Record<string, unknown>) =>
+    (reflector.getAllAndOverride as jest.Mock).mockImplementation((key: string) => metadata[key]);
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+    withMetadata({ [PERMISSION_KEY]: { resource: 'integrations', action: 'WRITE' } });
+  });
+
+  it('checks service account keys against their creator', async () => {
+    const request = {
+      user: { authType: 'apiKey', apiKeyId: 'key-1', serviceAccountId: 'sa-1' },
+    };
+    (resolveRequestUser as jest.Mock).mockResolvedValue({ id: 'user-1' });
+    (permissionsService.checkPermission as jest.Mock).mockResolvedValue({ allowed: true });
+
+    await expect(guard.canActivate(contextFor(request))).resolves.toBe(true);
+    expect(resolveRequestUser).toHaveBeenCalledWith(request);
+    expect(permissionsService.checkPermission).toHaveBeenCalledWith(
+      'user-1',
+      'integrations',
+      'WRITE',
+    );
+  });
+
+  it('rejects plain API keys on routes that do not allow them', async () => {
+    (resolveRequestUser as jest.Mock).mockResolvedValue(null);
+
+    await expect(
+      guard.canActivate(contextFor({ user: { authType: 'apiKey', apiKeyId: 'key-1' } })),
+    ).rejects.toThrow(ForbiddenException);
+  });
+
+  it('lets API keys through routes that allow them', async () => {
+    withMetadata({
+      [PERMISSION_KEY]: { resource: 'integrations', action: 'WRITE' },
+      [ALLOW_API_KEY_KEY]: true,
+    });
+
+    await expect(
+      guard.canActivate(contextFor({ user: { authType: 'apiKey', apiKeyId: 'key-1' } })),
+    ).resolves.toBe(true);
+    expect(resolveRequestUser).not.toHaveBeenCalled();
+  });
+
+  it('still checks Clerk users on routes that allow API keys', async () => {
+    withMetadata({
+      [PERMISSION_KEY]: { resource: 'integrations', action: 'WRITE' },
+      [ALLOW_API_KEY_KEY]: true,
+    });
+    (resolveRequestUser as jest.Mock).mockResolvedValue({ id: 'user-1' });
+    (permissionsService.checkPermission as jest.Mock).mockResolvedValue({ allowed: false });
+
+    await expect(
+      guard.canActivate(contextFor({ user: { authType: 'clerk', clerkId: 'clerk_1' } })),
+    ).rejects.toThrow(ForbiddenException);
+  });
+
+  it('rejects requests without a user', async () => {
+    (resolveRequestUser as jest.Mock).mockResolvedValue(null);
+
+    await expect(guard.canActivate(contextFor({}))).rejects.toThrow(ForbiddenException);
+  });
+
+  it('rejects callers without the permission', async () => {
+    (resolveRequestUser as jest.Mock).mockResolvedValue({ id: 'user-1' });
+    (permissionsService.checkPermission as jest.Mock).mockResolvedValue({
+      allowed: false,
+      reason: 'Denied',
+    });
+
+    await expect(guard.canActivate(contextFor({}))).rejects.toThrow('Denied');
+  });
+
+  it('allows routes without permission metadata', async () => {
+    withMetadata({});
+
+    await expect(guard.canActivate(contextFor({}))).resolves.toBe(true);
+    expect(resolveRequestUser).not.toHaveBeenCalled();
+  });
+});
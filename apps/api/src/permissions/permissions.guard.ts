@@ -7,9 +7,11 @@ import {
 } from '@nestjs/common';
 import { Reflector } from '@nestjs/core';
 import { PermissionsService, ResourceName, RESOURCES } from './permissions.service';
-import { PermissionAction, prisma } from '@signalcraft/database';
+import { PermissionAction } from '@signalcraft/database';
+import { resolveRequestUser } from '../common/guards/request-user';
 
 export const PERMISSION_KEY = 'required_permission';
+export const ALLOW_API_KEY_KEY = 'allow_api_key';
 
 export interface RequiredPermission {
     resource: ResourceName;
@@ -23,6 +25,12 @@ export interface RequiredPermission {
 export const RequirePermission = (resource: ResourceName, action: PermissionAction) =>
     SetMetadata(PERMISSION_KEY, { resource, action } as RequiredPermission);
 
+/**
+ * Decorator to let workspace API keys through the permission check
+ * Usage: @AllowApiKey()
+ */
+export const AllowApiKey = () => SetMetadata(ALLOW_API_KEY_KEY, true);
+
 @Injectable()
 export class PermissionsGuard implements CanActivate {
     constructor(
@@ -42,16 +50,17 @@ export class PermissionsGuard implements CanActivate {
         }
 
         const request = context.switchToHttp().getRequest();
-        let user = request.dbUser;
+        const allowApiKey = this.reflector.getAllAndOverride<boolean>(ALLOW_API_KEY_KEY, [
+            context.getHandler(),
+            context.getClass(),
+        ]);
 
-        if (!user) {
-            const clerkId = request.user?.clerkId;
-            if (clerkId) {
-                user = await prisma.user.findUnique({ where: { clerkId } });
-                request.dbUser = user;
-            }
+        // API keys are already scoped to their workspace by ApiKeyAuthGuard
+        if (allowApiKey && request.user?.authType === 'apiKey') {
+            return true;
         }
 
+        const user = await resolveRequestUser(request);
         const userId = user?.id;
 
         if (!userId) {
@@ -10,23 +10,59 @@ import {
   Delete,
 } from '@nestjs/common';
 import { ApiBearerAuth, ApiTags, ApiOperation } from '@nestjs/swagger';
+import { IsDateString, IsNotEmpty, IsOptional, IsString } from 'class-validator';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { ReleasesService } from './releases.service';
 
-class CreateReleaseDto {
+export class CreateReleaseDto {
+  @IsString()
+  @IsNotEmpty()
   version!: string;
+
+  @IsString()
+  @IsNotEmpty()
   environment!: string;
+
+  @IsOptional()
+  @IsString()
   project?: string;
+
+  @IsOptional()
+  @IsString()
   commitSha?: string;
+
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
+  deployId?: string;
+
+  @IsOptional()
+  @IsDateString()
   deployedAt?: string;
 }
 
-class UpdateReleaseDto {
+export class UpdateReleaseDto {
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
   version?: string;
+
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
   environment?: string;
+
+  @IsOptional()
+  @IsString()
   project?: string;
+
+  @IsOptional()
+  @IsString()
   commitSha?: string;
+
+  @IsOptional()
+  @IsDateString()
   deployedAt?: string;
 }
 
@@ -45,6 +81,7 @@ export class ReleasesController {
       environment: dto.environment,
       project: dto.project,
       commitSha: dto.commitSha,
+      deployId: dto.deployId,
       deployedAt: dto.deployedAt ? new Date(dto.deployedAt) : undefined,
     });
   }
@@ -0,0 +1,110 @@
+import { prisma } from '@signalcraft/database';
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { ReleasesService } from './releases.service';
+import { CreateReleaseDto } from './releases.controller';
+
+jest.mock('@signalcraft/database', () => ({
+  prisma: {
+    release: { upsert: jest.fn(), findFirst: jest.fn(), delete: jest.fn() },
+    alertGroup: { update: jest.fn() },
+  },
+}));
+
+describe('ReleasesService', () => {
+  const service = new ReleasesService();
+  const prismaClient = prisma as any;
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+  });
+
+  it('upserts the release of a version by default', async () => {
+    prismaClient.release.upsert.mockResolvedValue({ id: 'rel-1', version: 'v1' });
+
+    await service.createRelease('ws-1', {
+      version: 'v1',
+      environment: 'production',
+      commitSha: 'abc1234',
+    });
+
+    expect(prismaClient.release.upsert).toHaveBeenCalledWith(
+      expect.objectContaining({
+        where: {
+          workspaceId_version_environment_project_deployId: {
+            workspaceId: 'ws-1',
+            version: 'v1',
+            environment: 'production',
+            project: 'default',
+            deployId: '',
+          },
+        },
+      }),
+    );
+  });
+
+  it('keeps a separate release for each deploy ID', async () => {
+    prismaClient.release.upsert.mockResolvedValue({ id: 'rel-2', version: 'v1' });
+
+    await service.createRelease('ws-1', {
+      version: 'v1',
+      environment: 'production',
+      deployId: 'deploy-2',
+    });
+
+    const args = prismaClient.release.upsert.mock.calls[0][0];
+    expect(args.where.workspaceId_version_environment_project_deployId.deployId).toBe('deploy-2');
+    expect(args.create).toEqual(expect.objectContaining({ deployId: 'deploy-2' }));
+  });
+
+  it('links alerts to the latest deploy of a version', async () => {
+    prismaClient.release.findFirst.mockResolvedValue({ id: 'rel-2' });
+
+    await service.linkAlertToRelease('ws-1', 'group-1', 'v1', 'production');
+
+    expect(prismaClient.release.findFirst).toHaveBeenCalledWith({
+      where: { workspaceId: 'ws-1', version: 'v1', environment: 'production', project: 'default' },
+      orderBy: { deployedAt: 'desc' },
+    });
+    expect(prismaClient.release.upsert).not.toHaveBeenCalled();
+    expect(prismaClient.alertGroup.update).toHaveBeenCalledWith({
+      where: { id: 'group-1' },
+      data: { releaseId: 'rel-2' },
+    });
+  });
+
+  it('deletes only a release of the workspace', async () => {
+    prismaClient.release.findFirst.mockResolvedValue(null);
+
+    await expect(service.deleteRelease('ws-1', 'rel-1')).resolves.toBeNull();
+    expect(prismaClient.release.delete).not.toHaveBeenCalled();
+
+    prismaClient.release.findFirst.mockResolvedValue({ id: 'rel-1' });
+
+    await expect(service.deleteRelease('ws-1', 'rel-1')).resolves.toEqual({ success: true });
+    expect(prismaClient.release.delete).toHaveBeenCalledWith({ where: { id: 'rel-1' } });
+  });
+});
+
+describe('CreateReleaseDto', () => {
+  const options = { whitelist: true, forbidNonWhitelisted: true };
+
+  it('accepts the payload sent by the Terraform provider', async () => {
+    const dto = plainToInstance(CreateReleaseDto, {
+      version: 'v1.2.3',
+      environment: 'production',
+      project: 'payments-api',
+      commitSha: 'abc1234',
+      deployId: '6f1c2d3e-0000-4000-8000-000000000000',
+      deployedAt: '2030-01-01T00:00:00Z',
+    });
+
+    await expect(validate(dto, options)).resolves.toHaveLength(0);
+  });
+
+  it('requires a version and an environment', async () => {
+    const errors = await validate(plainToInstance(CreateReleaseDto, {}), options);
+
+    expect(errors.map((error) => error.property).sort()).toEqual(['environment', 'version']);
+  });
+});
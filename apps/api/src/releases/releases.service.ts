@@ -6,6 +6,7 @@ interface CreateReleaseDto {
   environment: string;
   project?: string;
   commitSha?: string;
+  deployId?: string;
   deployedAt?: Date;
 }
 
@@ -32,14 +33,19 @@ export interface ReleaseHealth {
 export class ReleasesService {
   private readonly logger = new Logger(ReleasesService.name);
 
+  /**
+   * Create or update the release of a version. Callers that pass a deployId
+   * record every deploy as its own release instead.
+   */
   async createRelease(workspaceId: string, data: CreateReleaseDto) {
     const release = await prisma.release.upsert({
       where: {
-        workspaceId_version_environment_project: {
+        workspaceId_version_environment_project_deployId: {
           workspaceId,
           version: data.version,
           environment: data.environment,
           project: data.project ?? 'default',
+          deployId: data.deployId ?? '',
         },
       },
       update: {
@@ -53,6 +59,7 @@ export class ReleasesService {
         environment: data.environment,
         project: data.project ?? 'default',
         commitSha: data.commitSha,
+        deployId: data.deployId ?? '',
         deployedAt: data.deployedAt ?? new Date(),
       },
     });
@@ -189,16 +196,10 @@ export class ReleasesService {
     environment: string,
     project = 'default',
   ) {
-    // Find or create the release
-    let release = await prisma.release.findUnique({
-      where: {
-        workspaceId_version_environment_project: {
-          workspaceId,
-          version,
-          environment,
-          project,
-        },
-      },
+    // Find the latest deploy of the version, or create the release
+    let release = await prisma.release.findFirst({
+      where: { workspaceId, version, environment, project },
+      orderBy: { deployedAt: 'desc' },
     });
 
     if (!release) {
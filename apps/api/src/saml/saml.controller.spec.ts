@@ -0,0 +1,61 @@
+import { ExecutionContext } from '@nestjs/common';
+import { GUARDS_METADATA, ROUTE_ARGS_METADATA } from '@nestjs/common/constants';
+import { SamlController } from './saml.controller';
+import { SamlService } from './saml.service';
+import { SamlValidatorService } from './saml-validator.service';
+import { ROLES_KEY } from '../common/decorators/roles.decorator';
+import { RolesGuard } from '../common/guards/roles.guard';
+
+describe('SamlController', () => {
+    const samlService = {
+        getSamlConfig: jest.fn(),
+        upsertSamlConfig: jest.fn(),
+    } as unknown as SamlService;
+    const controller = new SamlController(samlService, {} as SamlValidatorService);
+    const prototype = SamlController.prototype;
+
+    beforeEach(() => {
+        jest.clearAllMocks();
+    });
+
+    it.each(['getConfig', 'updateConfig'] as const)('requires OWNER or ADMIN for %s', (handler) => {
+        expect(Reflect.getMetadata(GUARDS_METADATA, prototype[handler])).toContain(RolesGuard);
+        expect(Reflect.getMetadata(ROLES_KEY, prototype[handler])).toEqual(['OWNER', 'ADMIN']);
+    });
+
+    it.each(['getConfig', 'updateConfig'])('resolves the workspace of an API key for %s', async (handler) => {
+        const args = Reflect.getMetadata(ROUTE_ARGS_METADATA, SamlController, handler);
+        const param: any = Object.values(args).find((arg: any) => arg.index === 0 && arg.factory);
+        const request = { user: { authType: 'apiKey', workspaceId: 'ws-1', apiKeyId: 'key-1' } };
+        const context = {
+            switchToHttp: () => ({ getRequest: () => request }),
+        } as unknown as ExecutionContext;
+
+        await expect(param.factory(param.data, context)).resolves.toBe('ws-1');
+    });
+
+    it('returns a disabled config when the workspace has none', async () => {
+        (samlService.getSamlConfig as jest.Mock).mockResolvedValue(null);
+
+        await expect(controller.getConfig('ws-1')).resolves.toEqual(
+            expect.objectContaining({ workspaceId: 'ws-1', enabled: false }),
+        );
+        expect(samlService.getSamlConfig).toHaveBeenCalledWith('ws-1');
+    });
+
+    it('updates the config of the workspace', async () => {
+        (samlService.upsertSamlConfig as jest.Mock).mockResolvedValue({
+            enabled: true,
+            enforced: false,
+            spEntityId: 'urn:signalcraft:ws-1',
+        });
+
+        await expect(controller.updateConfig('ws-1', { enabled: true })).resolves.toEqual({
+            success: true,
+            enabled: true,
+            enforced: false,
+            spEntityId: 'urn:signalcraft:ws-1',
+        });
+        expect(samlService.upsertSamlConfig).toHaveBeenCalledWith('ws-1', { enabled: true });
+    });
+});
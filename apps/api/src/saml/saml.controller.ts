@@ -5,13 +5,15 @@ import {
     Post,
     Body,
     Param,
-    Req,
     Res,
     UseGuards,
     Logger,
 } from '@nestjs/common';
-import { Response, Request } from 'express';
-import { ClerkAuthGuard } from '../auth/clerk-auth.guard';
+import { Response } from 'express';
+import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
+import { RolesGuard } from '../common/guards/roles.guard';
+import { Roles } from '../common/decorators/roles.decorator';
+import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { SamlService, SamlConfigDto } from './saml.service';
 import { SamlValidatorService } from './saml-validator.service';
 import {
@@ -21,13 +23,6 @@ import {
     InternalServerException,
 } from '../common/exceptions/base.exception';
 
-interface AuthenticatedRequest extends Request {
-    auth?: {
-        userId?: string;
-        workspaceId?: string;
-    };
-}
-
 @Controller('api/saml')
 export class SamlController {
     private readonly logger = new Logger(SamlController.name);
@@ -41,17 +36,14 @@ export class SamlController {
      * Get SAML configuration for the authenticated user's workspace
      */
     @Get('config')
-    @UseGuards(ClerkAuthGuard)
-    async getConfig(@Req() req: AuthenticatedRequest) {
-        const workspaceId = req.auth?.workspaceId;
-        if (!workspaceId) {
-            throw new ResourceNotFoundException('Workspace');
-        }
-
+    @UseGuards(ApiOrClerkAuthGuard, RolesGuard)
+    @Roles('OWNER', 'ADMIN')
+    async getConfig(@WorkspaceId() workspaceId: string) {
         const config = await this.samlService.getSamlConfig(workspaceId);
         if (!config) {
             // Return default empty config if none exists
             return {
+                workspaceId,
                 enabled: false,
                 enforced: false,
                 idpEntityId: null,
@@ -65,6 +57,7 @@ export class SamlController {
 
         // Don't expose full certificate in response for security
         return {
+            workspaceId,
             enabled: config.enabled,
             enforced: config.enforced,
             idpEntityId: config.idpEntityId,
@@ -80,16 +73,12 @@ export class SamlController {
      * Update SAML configuration
      */
     @Put('config')
-    @UseGuards(ClerkAuthGuard)
+    @UseGuards(ApiOrClerkAuthGuard, RolesGuard)
+    @Roles('OWNER', 'ADMIN')
     async updateConfig(
-        @Req() req: AuthenticatedRequest,
+        @WorkspaceId() workspaceId: string,
         @Body() body: SamlConfigDto,
     ) {
-        const workspaceId = req.auth?.workspaceId;
-        if (!workspaceId) {
-            throw new ResourceNotFoundException('Workspace');
-        }
-
         const config = await this.samlService.upsertSamlConfig(workspaceId, body);
         this.logger.log(`SAML config updated for workspace ${workspaceId}`);
 
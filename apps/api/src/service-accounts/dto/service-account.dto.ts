@@ -13,6 +13,19 @@ export class CreateServiceAccountDto {
   description?: string;
 }
 
+export class UpdateServiceAccountDto {
+  @ApiProperty({ example: 'Terraform Bot', required: false })
+  @IsString()
+  @IsNotEmpty()
+  @IsOptional()
+  name?: string;
+
+  @ApiProperty({ example: 'Service account for IaC automation', required: false, nullable: true })
+  @IsString()
+  @IsOptional()
+  description?: string | null;
+}
+
 export class CreateServiceAccountKeyDto {
   @ApiProperty({ example: 'Terraform CI key' })
   @IsString()
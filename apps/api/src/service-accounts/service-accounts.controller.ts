@@ -1,15 +1,20 @@
-import { Body, Controller, Delete, Get, Param, Post, UseGuards } from '@nestjs/common';
+import { Body, Controller, Delete, Get, Param, Patch, Post, UseGuards } from '@nestjs/common';
 import { ApiBearerAuth, ApiBody, ApiOperation, ApiTags } from '@nestjs/swagger';
-import { ClerkAuthGuard } from '../auth/clerk-auth.guard';
+import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { CurrentUser } from '../common/decorators/current-user.decorator';
+import { RequestActor } from '../api-keys/api-key.service';
 import { ServiceAccountsService } from './service-accounts.service';
-import { CreateServiceAccountDto, CreateServiceAccountKeyDto } from './dto/service-account.dto';
+import {
+  CreateServiceAccountDto,
+  CreateServiceAccountKeyDto,
+  UpdateServiceAccountDto,
+} from './dto/service-account.dto';
 
 @ApiTags('Service Accounts')
 @ApiBearerAuth()
 @Controller('api/service-accounts')
-@UseGuards(ClerkAuthGuard)
+@UseGuards(ApiOrClerkAuthGuard)
 export class ServiceAccountsController {
   constructor(private readonly serviceAccountsService: ServiceAccountsService) {}
 
@@ -18,10 +23,10 @@ export class ServiceAccountsController {
   @ApiBody({ type: CreateServiceAccountDto })
   async createServiceAccount(
     @WorkspaceId() workspaceId: string,
-    @CurrentUser() user: { clerkId?: string },
+    @CurrentUser() user: RequestActor,
     @Body() dto: CreateServiceAccountDto,
   ) {
-    return this.serviceAccountsService.createServiceAccount(workspaceId, user?.clerkId, dto);
+    return this.serviceAccountsService.createServiceAccount(workspaceId, user, dto);
   }
 
   @Get()
@@ -30,19 +35,40 @@ export class ServiceAccountsController {
     return this.serviceAccountsService.listServiceAccounts(workspaceId);
   }
 
+  @Patch(':id')
+  @ApiOperation({ summary: 'Update a service account' })
+  @ApiBody({ type: UpdateServiceAccountDto })
+  async updateServiceAccount(
+    @WorkspaceId() workspaceId: string,
+    @Param('id') serviceAccountId: string,
+    @Body() dto: UpdateServiceAccountDto,
+  ) {
+    return this.serviceAccountsService.updateServiceAccount(workspaceId, serviceAccountId, dto);
+  }
+
+  @Delete(':id')
+  @ApiOperation({ summary: 'Delete a service account and revoke its API keys' })
+  async deleteServiceAccount(
+    @WorkspaceId() workspaceId: string,
+    @Param('id') serviceAccountId: string,
+  ) {
+    await this.serviceAccountsService.deleteServiceAccount(workspaceId, serviceAccountId);
+    return { success: true, message: 'Service account deleted' };
+  }
+
   @Post(':id/keys')
   @ApiOperation({ summary: 'Create API key for a service account' })
   @ApiBody({ type: CreateServiceAccountKeyDto })
   async createServiceAccountKey(
     @WorkspaceId() workspaceId: string,
     @Param('id') serviceAccountId: string,
-    @CurrentUser() user: { clerkId?: string },
+    @CurrentUser() user: RequestActor,
     @Body() dto: CreateServiceAccountKeyDto,
   ) {
     return this.serviceAccountsService.createServiceAccountKey(
       workspaceId,
       serviceAccountId,
-      user?.clerkId,
+      user,
       dto,
     );
   }
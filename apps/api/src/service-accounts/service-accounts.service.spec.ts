@@ -0,0 +1,83 @@
+import { NotFoundException } from '@nestjs/common';
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { prisma } from '@signalcraft/database';
+import { ServiceAccountsService } from './service-accounts.service';
+import { UpdateServiceAccountDto } from './dto/service-account.dto';
+
+jest.mock('@signalcraft/database', () => ({
+  prisma: {
+    $transaction: jest.fn(),
+    serviceAccount: { create: jest.fn(), findFirst: jest.fn(), update: jest.fn(), delete: jest.fn() },
+    apiKey: { updateMany: jest.fn() },
+  },
+}));
+
+describe('ServiceAccountsService', () => {
+  const apiKeyService = { resolveCreatorId: jest.fn() };
+  const service = new ServiceAccountsService(apiKeyService as any);
+  const prismaClient = prisma as any;
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+  });
+
+  it('attributes an account created with an API key to the key creator', async () => {
+    apiKeyService.resolveCreatorId.mockResolvedValue('user-1');
+    prismaClient.serviceAccount.create.mockResolvedValue({ id: 'sa-1' });
+
+    await service.createServiceAccount('ws-1', { apiKeyId: 'key-1' }, { name: 'Terraform' });
+    expect(apiKeyService.resolveCreatorId).toHaveBeenCalledWith({ apiKeyId: 'key-1' });
+    expect(prismaClient.serviceAccount.create).toHaveBeenCalledWith({
+      data: { workspaceId: 'ws-1', name: 'Terraform', description: null, createdBy: 'user-1' },
+    });
+  });
+
+  it('clears the description when updated to null', async () => {
+    prismaClient.serviceAccount.findFirst.mockResolvedValue({ id: 'sa-1' });
+
+    await service.updateServiceAccount('ws-1', 'sa-1', { description: null });
+    expect(prismaClient.serviceAccount.update).toHaveBeenCalledWith({
+      where: { id: 'sa-1' },
+      data: { description: null },
+    });
+  });
+
+  it('revokes and detaches the keys of a deleted account', async () => {
+    prismaClient.serviceAccount.findFirst.mockResolvedValue({ id: 'sa-1' });
+    prismaClient.apiKey.updateMany.mockImplementation((args: any) => args);
+    prismaClient.serviceAccount.delete.mockImplementation((args: any) => args);
+
+    await service.deleteServiceAccount('ws-1', 'sa-1');
+    expect(prismaClient.$transaction).toHaveBeenCalledWith([
+      { where: { serviceAccountId: 'sa-1', revokedAt: null }, data: { revokedAt: expect.any(Date) } },
+      { where: { serviceAccountId: 'sa-1' }, data: { serviceAccountId: null } },
+      { where: { id: 'sa-1' } },
+    ]);
+  });
+
+  it.each(['updateServiceAccount', 'deleteServiceAccount'])(
+    'fails %s for an account of another workspace',
+    async (method) => {
+      prismaClient.serviceAccount.findFirst.mockResolvedValue(null);
+
+      await expect((service as any)[method]('ws-1', 'sa-1', {})).rejects.toBeInstanceOf(NotFoundException);
+      expect(prismaClient.$transaction).not.toHaveBeenCalled();
+    },
+  );
+});
+
+describe('UpdateServiceAccountDto', () => {
+  const errorsFor = async (body: Record<string, unknown>) =>
+    (
+      await validate(plainToInstance(UpdateServiceAccountDto, body), { whitelist: true, forbidNonWhitelisted: true })
+    ).map((error) => error.property);
+
+  it.each([{}, { name: 'Terraform' }, { description: null }])('accepts %p', async (body) => {
+    await expect(errorsFor(body)).resolves.toEqual([]);
+  });
+
+  it('rejects an empty name', async () => {
+    await expect(errorsFor({ name: '' })).resolves.toEqual(['name']);
+  });
+});
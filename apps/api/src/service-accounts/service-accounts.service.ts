@@ -1,30 +1,22 @@
-import { BadRequestException, Injectable, NotFoundException } from '@nestjs/common';
+import { Injectable, NotFoundException } from '@nestjs/common';
 import { prisma } from '@signalcraft/database';
-import { ApiKeyService } from '../api-keys/api-key.service';
-import { CreateServiceAccountDto, CreateServiceAccountKeyDto } from './dto/service-account.dto';
+import { ApiKeyService, RequestActor } from '../api-keys/api-key.service';
+import {
+  CreateServiceAccountDto,
+  CreateServiceAccountKeyDto,
+  UpdateServiceAccountDto,
+} from './dto/service-account.dto';
 
 @Injectable()
 export class ServiceAccountsService {
   constructor(private readonly apiKeyService: ApiKeyService) {}
 
-  private async getUserIdByClerkId(clerkId: string): Promise<string> {
-    const user = await prisma.user.findUnique({ where: { clerkId } });
-    if (!user) {
-      throw new NotFoundException('User not found');
-    }
-    return user.id;
-  }
-
   async createServiceAccount(
     workspaceId: string,
-    clerkId: string | undefined,
+    actor: RequestActor | undefined,
     dto: CreateServiceAccountDto,
   ) {
-    if (!clerkId) {
-      throw new BadRequestException('Missing user context');
-    }
-
-    const userId = await this.getUserIdByClerkId(clerkId);
+    const userId = await this.apiKeyService.resolveCreatorId(actor);
 
     const prismaClient = prisma as any;
     return prismaClient.serviceAccount.create({
@@ -68,16 +60,68 @@ export class ServiceAccountsService {
     }));
   }
 
-  async createServiceAccountKey(
+  async updateServiceAccount(
     workspaceId: string,
     serviceAccountId: string,
-    clerkId: string | undefined,
-    dto: CreateServiceAccountKeyDto,
+    dto: UpdateServiceAccountDto,
   ) {
-    if (!clerkId) {
-      throw new BadRequestException('Missing user context');
+    const prismaClient = prisma as any;
+    const serviceAccount = await prismaClient.serviceAccount.findFirst({
+      where: {
+        id: serviceAccountId,
+        workspaceId,
+      },
+    });
+
+    if (!serviceAccount) {
+      throw new NotFoundException('Service account not found');
     }
 
+    return prismaClient.serviceAccount.update({
+      where: { id: serviceAccountId },
+      data: {
+        ...(dto.name !== undefined && { name: dto.name }),
+        ...(dto.description !== undefined && { description: dto.description }),
+      },
+    });
+  }
+
+  /**
+   * Delete a service account. Its keys are revoked and detached first so they
+   * remain as audit history on the workspace.
+   */
+  async deleteServiceAccount(workspaceId: string, serviceAccountId: string) {
+    const prismaClient = prisma as any;
+    const serviceAccount = await prismaClient.serviceAccount.findFirst({
+      where: {
+        id: serviceAccountId,
+        workspaceId,
+      },
+    });
+
+    if (!serviceAccount) {
+      throw new NotFoundException('Service account not found');
+    }
+
+    await prismaClient.$transaction([
+      prismaClient.apiKey.updateMany({
+        where: { serviceAccountId, revokedAt: null },
+        data: { revokedAt: new Date() },
+      }),
+      prismaClient.apiKey.updateMany({
+        where: { serviceAccountId },
+        data: { serviceAccountId: null },
+      }),
+      prismaClient.serviceAccount.delete({ where: { id: serviceAccountId } }),
+    ]);
+  }
+
+  async createServiceAccountKey(
+    workspaceId: string,
+    serviceAccountId: string,
+    actor: RequestActor | undefined,
+    dto: CreateServiceAccountKeyDto,
+  ) {
     const prismaClient = prisma as any;
     const serviceAccount = await prismaClient.serviceAccount.findFirst({
       where: {
@@ -90,7 +134,7 @@ export class ServiceAccountsService {
       throw new NotFoundException('Service account not found');
     }
 
-    const userId = await this.getUserIdByClerkId(clerkId);
+    const userId = await this.apiKeyService.resolveCreatorId(actor);
 
     return this.apiKeyService.createApiKey({
       workspaceId,
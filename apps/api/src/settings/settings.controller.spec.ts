@@ -0,0 +1,20 @@
+import { GUARDS_METADATA } from '@nestjs/common/constants';
+import { SettingsController } from './settings.controller';
+import { ROLES_KEY } from '../common/decorators/roles.decorator';
+import { RolesGuard } from '../common/guards/roles.guard';
+
+describe('SettingsController', () => {
+  const prototype = SettingsController.prototype;
+
+  it.each(['updateEmailSettings', 'deleteEmailSettings'] as const)(
+    'requires OWNER or ADMIN for %s',
+    (handler) => {
+      expect(Reflect.getMetadata(GUARDS_METADATA, prototype[handler])).toContain(RolesGuard);
+      expect(Reflect.getMetadata(ROLES_KEY, prototype[handler])).toEqual(['OWNER', 'ADMIN']);
+    },
+  );
+
+  it('leaves reading the email settings to any member', () => {
+    expect(Reflect.getMetadata(ROLES_KEY, prototype.getEmailSettings)).toBeUndefined();
+  });
+});
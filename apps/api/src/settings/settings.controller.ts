@@ -1,5 +1,6 @@
 import {
   Controller,
+  Delete,
   Get,
   Post,
   Put,
@@ -10,7 +11,10 @@ import {
 } from '@nestjs/common';
 import { ApiBearerAuth, ApiTags, ApiOperation, ApiBody } from '@nestjs/swagger';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
+import { RolesGuard } from '../common/guards/roles.guard';
+import { Roles } from '../common/decorators/roles.decorator';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
+import { UpdateWorkspaceDto } from '../workspaces/dto/workspace.dto';
 import { SettingsService, NotificationPreferences } from './settings.service';
 
 @ApiTags('settings')
@@ -30,7 +34,7 @@ export class SettingsController {
 
   @Put('workspace')
   @ApiOperation({ summary: 'Update workspace settings' })
-  async updateWorkspace(@WorkspaceId() workspaceId: string, @Body() data: { name: string }) {
+  async updateWorkspace(@WorkspaceId() workspaceId: string, @Body() data: UpdateWorkspaceDto) {
     return this.settingsService.updateWorkspaceSettings(workspaceId, data);
   }
 
@@ -84,4 +88,29 @@ export class SettingsController {
   ) {
     return this.settingsService.testTwilioSettings(workspaceId, data);
   }
+
+  @Get('email')
+  @ApiOperation({ summary: 'Get SendGrid email settings' })
+  async getEmailSettings(@WorkspaceId() workspaceId: string) {
+    return this.settingsService.getEmailSettings(workspaceId);
+  }
+
+  @Put('email')
+  @UseGuards(RolesGuard)
+  @Roles('OWNER', 'ADMIN')
+  @ApiOperation({ summary: 'Update SendGrid email settings' })
+  async updateEmailSettings(
+    @WorkspaceId() workspaceId: string,
+    @Body() data: { apiKey: string; fromEmail: string; fromName?: string | null },
+  ) {
+    return this.settingsService.setEmailSettings(workspaceId, data);
+  }
+
+  @Delete('email')
+  @UseGuards(RolesGuard)
+  @Roles('OWNER', 'ADMIN')
+  @ApiOperation({ summary: 'Remove SendGrid email settings' })
+  async deleteEmailSettings(@WorkspaceId() workspaceId: string) {
+    return this.settingsService.deleteEmailSettings(workspaceId);
+  }
 }
@@ -0,0 +1,78 @@
+import { prisma } from '@signalcraft/database';
+import { SettingsService } from './settings.service';
+
+jest.mock('@signalcraft/database', () => ({
+  prisma: {
+    notificationPreference: { findUnique: jest.fn(), upsert: jest.fn() },
+    workspace: { update: jest.fn() },
+  },
+}));
+
+describe('SettingsService notification preferences', () => {
+  const service = new SettingsService({} as any, {} as any, {} as any);
+  const prismaClient = prisma as any;
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+  });
+
+  it('returns the defaults for a workspace without saved preferences', async () => {
+    prismaClient.notificationPreference.findUnique.mockResolvedValue(null);
+
+    await expect(service.getNotificationPreferences('ws-1')).resolves.toEqual({
+      defaultChannel: '#alerts',
+      quietHoursEnabled: false,
+      quietHoursStart: '22:00',
+      quietHoursEnd: '08:00',
+      escalationEnabled: true,
+      escalationMinutes: 15,
+    });
+    expect(prismaClient.notificationPreference.findUnique).toHaveBeenCalledWith(
+      expect.objectContaining({ where: { workspaceId: 'ws-1' } }),
+    );
+  });
+
+  it('stores the preferences of each workspace separately', async () => {
+    prismaClient.notificationPreference.upsert.mockResolvedValue({ defaultChannel: '#ops' });
+
+    await service.updateNotificationPreferences('ws-1', { defaultChannel: '#ops' });
+
+    expect(prismaClient.notificationPreference.upsert).toHaveBeenCalledWith(
+      expect.objectContaining({
+        where: { workspaceId: 'ws-1' },
+        create: expect.objectContaining({ workspaceId: 'ws-1', defaultChannel: '#ops' }),
+        update: expect.objectContaining({ defaultChannel: '#ops', escalationMinutes: undefined }),
+      }),
+    );
+  });
+});
+
+describe('SettingsService workspace settings', () => {
+  const service = new SettingsService({} as any, {} as any, {} as any);
+  const prismaClient = prisma as any;
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+  });
+
+  it('updates only the thresholds that are sent', async () => {
+    await service.updateWorkspaceSettings('ws-1', { name: 'Ops', highVelocityThreshold: 25 });
+
+    expect(prismaClient.workspace.update).toHaveBeenCalledWith({
+      where: { id: 'ws-1' },
+      data: { name: 'Ops', highVelocityThreshold: 25 },
+    });
+  });
+
+  it('updates all impact thresholds together', async () => {
+    await service.updateWorkspaceSettings('ws-1', {
+      highImpactUserThreshold: 100,
+      mediumImpactUserThreshold: 20,
+    });
+
+    expect(prismaClient.workspace.update).toHaveBeenCalledWith({
+      where: { id: 'ws-1' },
+      data: { highImpactUserThreshold: 100, mediumImpactUserThreshold: 20 },
+    });
+  });
+});
@@ -1,5 +1,7 @@
-import { Injectable } from '@nestjs/common';
+import { Injectable, Logger } from '@nestjs/common';
 import { prisma } from '@signalcraft/database';
+import axios from 'axios';
+import { EncryptionService } from '../common/encryption/encryption.service';
 import { SecretsService } from '../common/secrets/secrets.service';
 import { TwilioNotificationService } from '../notifications/twilio-notification.service';
 
@@ -12,21 +14,33 @@ export interface NotificationPreferences {
   escalationMinutes: number;
 }
 
+const NOTIFICATION_PREFERENCE_FIELDS = {
+  defaultChannel: true,
+  quietHoursEnabled: true,
+  quietHoursStart: true,
+  quietHoursEnd: true,
+  escalationEnabled: true,
+  escalationMinutes: true,
+} as const;
+
+// Returned until a workspace saves its own preferences; matches the column defaults.
+const DEFAULT_NOTIFICATION_PREFERENCES: NotificationPreferences = {
+  defaultChannel: '#alerts',
+  quietHoursEnabled: false,
+  quietHoursStart: '22:00',
+  quietHoursEnd: '08:00',
+  escalationEnabled: true,
+  escalationMinutes: 15,
+};
+
 @Injectable()
 export class SettingsService {
-  // In-memory mock for notification preferences since we don't have a model yet
-  private mockPreferences: NotificationPreferences = {
-    defaultChannel: '#alerts',
-    quietHoursEnabled: false,
-    quietHoursStart: '22:00',
-    quietHoursEnd: '08:00',
-    escalationEnabled: true,
-    escalationMinutes: 15,
-  };
+  private readonly logger = new Logger(SettingsService.name);
 
   constructor(
     private readonly secretsService: SecretsService,
     private readonly twilioService: TwilioNotificationService,
+    private readonly encryptionService: EncryptionService,
   ) {}
 
   async getWorkspaceSettings(workspaceId: string) {
@@ -90,13 +104,36 @@ export class SettingsService {
     return { success: true, message: `Invitation sent to ${email}` };
   }
 
-  getNotificationPreferences(workspaceId: string) {
-    return this.mockPreferences;
+  async getNotificationPreferences(workspaceId: string): Promise<NotificationPreferences> {
+    const preferences = await prisma.notificationPreference.findUnique({
+      where: { workspaceId },
+      select: NOTIFICATION_PREFERENCE_FIELDS,
+    });
+    return preferences ?? DEFAULT_NOTIFICATION_PREFERENCES;
   }
 
-  updateNotificationPreferences(workspaceId: string, preferences: NotificationPreferences) {
-    this.mockPreferences = { ...this.mockPreferences, ...preferences };
-    return this.mockPreferences;
+  /**
+   * Merge the given preferences into the workspace's current ones. Fields
+   * that are not sent keep their value.
+   */
+  async updateNotificationPreferences(
+    workspaceId: string,
+    preferences: Partial<NotificationPreferences>,
+  ): Promise<NotificationPreferences> {
+    const data = {
+      defaultChannel: preferences.defaultChannel,
+      quietHoursEnabled: preferences.quietHoursEnabled,
+      quietHoursStart: preferences.quietHoursStart,
+      quietHoursEnd: preferences.quietHoursEnd,
+      escalationEnabled: preferences.escalationEnabled,
+      escalationMinutes: preferences.escalationMinutes,
+    };
+    return prisma.notificationPreference.upsert({
+      where: { workspaceId },
+      create: { workspaceId, ...data },
+      update: data,
+      select: NOTIFICATION_PREFERENCE_FIELDS,
+    });
   }
 
   async getTwilioSettings(workspaceId: string) {
@@ -163,4 +200,98 @@ export class SettingsService {
     );
     return { success: ok };
   }
+
+  /**
+   * Get the SendGrid sender. An unverified sender is re-checked against
+   * SendGrid so verification done there shows up without saving again.
+   */
+  async getEmailSettings(workspaceId: string) {
+    let integration = await prisma.emailIntegration.findUnique({ where: { workspaceId } });
+    if (!integration) {
+      return { configured: false };
+    }
+
+    if (!integration.verified) {
+      const verified = await this.isSenderVerified(
+        this.decryptEmailApiKey(integration.apiKey),
+        integration.fromEmail,
+      );
+      if (verified) {
+        integration = await prisma.emailIntegration.update({
+          where: { workspaceId },
+          data: { verified: true },
+        });
+      }
+    }
+
+    return {
+      configured: true,
+      fromEmail: integration.fromEmail,
+      fromName: integration.fromName,
+      verified: integration.verified,
+    };
+  }
+
+  async setEmailSettings(
+    workspaceId: string,
+    data: { apiKey: string; fromEmail: string; fromName?: string | null },
+  ) {
+    const verified = await this.isSenderVerified(data.apiKey, data.fromEmail);
+    const values = {
+      apiKey: this.encryptionService.encrypt(data.apiKey),
+      fromEmail: data.fromEmail,
+      fromName: data.fromName ?? null,
+      verified,
+    };
+    await prisma.emailIntegration.upsert({
+      where: { workspaceId },
+      create: { workspaceId, ...values },
+      update: values,
+    });
+    return this.getEmailSettings(workspaceId);
+  }
+
+  async deleteEmailSettings(workspaceId: string) {
+    await prisma.emailIntegration.deleteMany({ where: { workspaceId } });
+    return { success: true };
+  }
+
+  private decryptEmailApiKey(apiKey: string) {
+    try {
+      return apiKey.includes(':') ? this.encryptionService.decrypt(apiKey) : apiKey;
+    } catch (e) {
+      // Legacy plaintext key
+      return apiKey;
+    }
+  }
+
+  /**
+   * A sender is verified when SendGrid lists it as a verified single sender
+   * or its domain is authenticated.
+   */
+  private async isSenderVerified(apiKey: string, fromEmail: string): Promise<boolean> {
+    const headers = { Authorization: `Bearer ${apiKey}` };
+    const email = fromEmail.toLowerCase();
+    const domain = email.split('@')[1];
+
+    try {
+      const senders = await axios.get('https://api.sendgrid.com/v3/verified_senders', {
+        headers,
+      });
+      const results: Array<{ from_email: string; verified: boolean }> =
+        senders.data?.results ?? [];
+      if (results.some((s) => s.verified && s.from_email.toLowerCase() === email)) {
+        return true;
+      }
+
+      const domains = await axios.get('https://api.sendgrid.com/v3/whitelabel/domains', {
+        headers,
+      });
+      const authenticated: Array<{ domain: string; valid: boolean }> = domains.data ?? [];
+      return authenticated.some((d) => d.valid && d.domain.toLowerCase() === domain);
+    } catch (error: any) {
+      this.logger.warn(`SendGrid sender verification check failed: ${error.message}`);
+      return false;
+    }
+  }
 }
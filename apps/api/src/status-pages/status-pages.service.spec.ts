@@ -0,0 +1,59 @@
+import { ConflictException } from '@nestjs/common';
+import { prisma, Prisma } from '@signalcraft/database';
+import { StatusPagesService } from './status-pages.service';
+
+jest.mock('@signalcraft/database', () => {
+  class PrismaClientKnownRequestError extends Error {
+    constructor(
+      message: string,
+      public code: string,
+    ) {
+      super(message);
+    }
+  }
+  return {
+    prisma: { statusPage: { create: jest.fn() } },
+    Prisma: { PrismaClientKnownRequestError },
+    StatusPageVisibility: { PUBLIC: 'PUBLIC' },
+  };
+});
+
+describe('StatusPagesService createStatusPage', () => {
+  const service = new StatusPagesService({} as any);
+  const prismaClient = prisma as any;
+  const KnownRequestError = Prisma.PrismaClientKnownRequestError as any;
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+  });
+
+  it('creates the page with a normalized slug', async () => {
+    prismaClient.statusPage.create.mockResolvedValue({ id: 'page-1' });
+
+    await expect(
+      service.createStatusPage('ws-1', { slug: ' Acme Status ', title: 'Acme' }),
+    ).resolves.toEqual({ id: 'page-1' });
+    expect(prismaClient.statusPage.create).toHaveBeenCalledWith({
+      data: expect.objectContaining({ workspaceId: 'ws-1', slug: 'acme-status' }),
+    });
+  });
+
+  it('reports a taken slug as a conflict', async () => {
+    prismaClient.statusPage.create.mockRejectedValue(
+      new KnownRequestError('Unique constraint failed on the fields: (`slug`)', 'P2002'),
+    );
+
+    await expect(
+      service.createStatusPage('ws-1', { slug: 'acme', title: 'Acme' }),
+    ).rejects.toBeInstanceOf(ConflictException);
+  });
+
+  it('rethrows other database errors', async () => {
+    const error = new KnownRequestError('Foreign key constraint failed', 'P2003');
+    prismaClient.statusPage.create.mockRejectedValue(error);
+
+    await expect(service.createStatusPage('ws-1', { slug: 'acme', title: 'Acme' })).rejects.toBe(
+      error,
+    );
+  });
+});
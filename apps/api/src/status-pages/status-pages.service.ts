@@ -1,6 +1,7 @@
-import { Injectable } from '@nestjs/common';
+import { ConflictException, Injectable } from '@nestjs/common';
 import {
   prisma,
+  Prisma,
   StatusIncidentStatus,
   StatusIncidentImpact,
   StatusPageVisibility,
@@ -49,15 +50,22 @@ export class StatusPagesService {
 
   async createStatusPage(workspaceId: string, dto: CreateStatusPageDto) {
     const slug = this.normalizeSlug(dto.slug);
-    return prisma.statusPage.create({
-      data: {
-        workspaceId,
-        slug,
-        title: dto.title,
-        description: dto.description,
-        visibility: dto.visibility ?? StatusPageVisibility.PUBLIC,
-      },
-    });
+    try {
+      return await prisma.statusPage.create({
+        data: {
+          workspaceId,
+          slug,
+          title: dto.title,
+          description: dto.description,
+          visibility: dto.visibility ?? StatusPageVisibility.PUBLIC,
+        },
+      });
+    } catch (error) {
+      if (error instanceof Prisma.PrismaClientKnownRequestError && error.code === 'P2002') {
+        throw new ConflictException(`Status page slug "${slug}" is already taken`);
+      }
+      throw error;
+    }
   }
 
   async updateStatusPage(workspaceId: string, id: string, dto: UpdateStatusPageDto) {
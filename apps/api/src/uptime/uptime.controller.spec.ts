@@ -0,0 +1,54 @@
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { CreateUptimeCheckDto, UpdateUptimeCheckDto } from './uptime.controller';
+
+const options = { whitelist: true, forbidNonWhitelisted: true };
+
+const errorsFor = async (dtoClass: any, body: Record<string, unknown>) =>
+  (await validate(plainToInstance(dtoClass, body), options)).map((error) => error.property);
+
+// Shaped like the payload sent by signalcraft_uptime_check.
+const check = {
+  name: 'API',
+  url: 'https://api.example.com/health',
+  method: 'GET',
+  interval: 60,
+  timeout: 10,
+  headers: { Authorization: 'Bearer token' },
+  expectedStatus: null,
+  enabled: true,
+};
+
+describe('CreateUptimeCheckDto', () => {
+  it.each([check, { name: 'API', url: 'http://localhost:8080/health' }])(
+    'accepts %p',
+    async (body) => {
+      await expect(errorsFor(CreateUptimeCheckDto, body)).resolves.toEqual([]);
+    },
+  );
+
+  it.each([
+    [{ ...check, name: '' }, 'name'],
+    [{ ...check, url: 'api.example.com' }, 'url'],
+    [{ ...check, method: 'TRACE' }, 'method'],
+    [{ ...check, interval: 0 }, 'interval'],
+    [{ ...check, timeout: 1.5 }, 'timeout'],
+    [{ ...check, headers: 'Authorization' }, 'headers'],
+    [{ ...check, workspaceId: 'ws-2' }, 'workspaceId'],
+  ])('rejects %p', async (body, property) => {
+    await expect(errorsFor(CreateUptimeCheckDto, body)).resolves.toEqual([property]);
+  });
+});
+
+describe('UpdateUptimeCheckDto', () => {
+  it.each([check, { enabled: false }])('accepts %p', async (body) => {
+    await expect(errorsFor(UpdateUptimeCheckDto, body)).resolves.toEqual([]);
+  });
+
+  it.each([
+    [{ enabled: 'false' }, 'enabled'],
+    [{ expectedStatus: '200' }, 'expectedStatus'],
+  ])('rejects %p', async (body, property) => {
+    await expect(errorsFor(UpdateUptimeCheckDto, body)).resolves.toEqual([property]);
+  });
+});
@@ -10,28 +10,92 @@ import {
   UseGuards,
 } from '@nestjs/common';
 import { ApiBearerAuth, ApiTags, ApiOperation } from '@nestjs/swagger';
+import {
+  IsBoolean,
+  IsIn,
+  IsInt,
+  IsNotEmpty,
+  IsObject,
+  IsOptional,
+  IsString,
+  IsUrl,
+  Min,
+} from 'class-validator';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { UptimeService } from './uptime.service';
 
-class CreateUptimeCheckDto {
+const HTTP_METHODS = ['GET', 'HEAD', 'POST', 'PUT', 'PATCH', 'DELETE', 'OPTIONS'];
+
+export class CreateUptimeCheckDto {
+  @IsString()
+  @IsNotEmpty()
   name!: string;
+
+  @IsUrl({ require_protocol: true, require_tld: false })
   url!: string;
+
+  @IsOptional()
+  @IsIn(HTTP_METHODS)
   method?: string;
+
+  @IsOptional()
+  @IsInt()
+  @Min(1)
   interval?: number;
+
+  @IsOptional()
+  @IsInt()
+  @Min(1)
   timeout?: number;
+
+  @IsOptional()
+  @IsBoolean()
+  enabled?: boolean;
+
+  @IsOptional()
+  @IsObject()
   headers?: Record<string, string>;
+
+  @IsOptional()
+  @IsInt()
   expectedStatus?: number;
 }
 
-class UpdateUptimeCheckDto {
+export class UpdateUptimeCheckDto {
+  @IsOptional()
+  @IsString()
+  @IsNotEmpty()
   name?: string;
+
+  @IsOptional()
+  @IsUrl({ require_protocol: true, require_tld: false })
   url?: string;
+
+  @IsOptional()
+  @IsIn(HTTP_METHODS)
   method?: string;
+
+  @IsOptional()
+  @IsInt()
+  @Min(1)
   interval?: number;
+
+  @IsOptional()
+  @IsInt()
+  @Min(1)
   timeout?: number;
+
+  @IsOptional()
+  @IsBoolean()
   enabled?: boolean;
+
+  @IsOptional()
+  @IsObject()
   headers?: Record<string, string>;
+
+  @IsOptional()
+  @IsInt()
   expectedStatus?: number;
 }
 
@@ -9,6 +9,7 @@ interface CreateUptimeCheckDto {
     method?: string;
     interval?: number;
     timeout?: number;
+    enabled?: boolean;
     headers?: Record<string, string>;
     expectedStatus?: number;
 }
@@ -50,6 +51,7 @@ export class UptimeService {
                 method: dto.method ?? 'GET',
                 interval: dto.interval ?? 60,
                 timeout: dto.timeout ?? 30,
+                enabled: dto.enabled ?? true,
                 headers: dto.headers ?? undefined,
                 expectedStatus: dto.expectedStatus ?? null,
             },
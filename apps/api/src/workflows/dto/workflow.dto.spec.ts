@@ -0,0 +1,52 @@
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { CreateWorkflowDto, UpdateWorkflowDto } from './workflow.dto';
+
+const options = { whitelist: true, forbidNonWhitelisted: true };
+
+// Shaped like the payload sent by signalcraft_remediation_workflow.
+const payload = {
+    name: 'Restart on crash',
+    description: 'Restarts the service',
+    enabled: true,
+    trigger: { severity: ['CRITICAL'], tags: { service: 'api' } },
+    steps: [
+        {
+            id: 'restart',
+            type: 'HTTP_REQUEST',
+            name: 'Restart',
+            config: { url: 'https://example.com/restart', method: 'POST' },
+            onSuccess: ['notify'],
+        },
+        { id: 'notify', type: 'SLACK_MESSAGE', name: 'Notify', config: {} },
+    ],
+};
+
+describe('Workflow DTOs', () => {
+    it('accepts steps as an array on create', async () => {
+        const dto = plainToInstance(CreateWorkflowDto, payload);
+
+        await expect(validate(dto, options)).resolves.toHaveLength(0);
+    });
+
+    it('accepts steps as an array on update', async () => {
+        const dto = plainToInstance(UpdateWorkflowDto, { steps: payload.steps });
+
+        await expect(validate(dto, options)).resolves.toHaveLength(0);
+    });
+
+    it('rejects steps sent as an object', async () => {
+        const dto = plainToInstance(CreateWorkflowDto, { ...payload, steps: { restart: payload.steps[0] } });
+
+        const errors = await validate(dto, options);
+        expect(errors.map((error) => error.property)).toEqual(['steps']);
+    });
+
+    it('rejects a step with an unknown type', async () => {
+        const steps = [{ ...payload.steps[1], type: 'EMAIL' }];
+        const dto = plainToInstance(UpdateWorkflowDto, { steps });
+
+        const errors = await validate(dto, options);
+        expect(errors.map((error) => error.property)).toEqual(['steps']);
+    });
+});
@@ -1,5 +1,47 @@
-import { IsString, IsOptional, IsBoolean, IsObject } from 'class-validator';
+import {
+    IsString,
+    IsOptional,
+    IsBoolean,
+    IsObject,
+    IsArray,
+    IsIn,
+    ValidateNested,
+} from 'class-validator';
+import { Type } from 'class-transformer';
 import { ApiProperty, ApiPropertyOptional } from '@nestjs/swagger';
+import { WorkflowStep } from '../workflow.types';
+
+const STEP_TYPES: WorkflowStep['type'][] = ['HTTP_REQUEST', 'SCRIPT', 'WEBHOOK', 'SLACK_MESSAGE', 'DELAY'];
+
+export class WorkflowStepDto implements WorkflowStep {
+    @ApiProperty({ description: 'Step ID, referenced by onSuccess/onFailure' })
+    @IsString()
+    id!: string;
+
+    @ApiProperty({ description: 'Step type', enum: STEP_TYPES })
+    @IsIn(STEP_TYPES)
+    type!: WorkflowStep['type'];
+
+    @ApiProperty({ description: 'Step name' })
+    @IsString()
+    name!: string;
+
+    @ApiProperty({ description: 'Step configuration' })
+    @IsObject()
+    config!: Record<string, any>;
+
+    @ApiPropertyOptional({ description: 'IDs of the next steps on success', type: [String] })
+    @IsArray()
+    @IsString({ each: true })
+    @IsOptional()
+    onSuccess?: string[];
+
+    @ApiPropertyOptional({ description: 'IDs of the next steps on failure', type: [String] })
+    @IsArray()
+    @IsString({ each: true })
+    @IsOptional()
+    onFailure?: string[];
+}
 
 export class CreateWorkflowDto {
     @ApiProperty({ description: 'Workflow name' })
@@ -15,9 +57,11 @@ export class CreateWorkflowDto {
     @IsObject()
     trigger!: Record<string, any>;
 
-    @ApiProperty({ description: 'Array of workflow steps' })
-    @IsObject()
-    steps!: Record<string, any>;
+    @ApiProperty({ description: 'Array of workflow steps', type: [WorkflowStepDto] })
+    @IsArray()
+    @ValidateNested({ each: true })
+    @Type(() => WorkflowStepDto)
+    steps!: WorkflowStepDto[];
 
     @ApiPropertyOptional({ description: 'Enable/disable workflow', default: true })
     @IsBoolean()
@@ -41,10 +85,12 @@ export class UpdateWorkflowDto {
     @IsOptional()
     trigger?: Record<string, any>;
 
-    @ApiPropertyOptional({ description: 'Workflow steps' })
-    @IsObject()
+    @ApiPropertyOptional({ description: 'Workflow steps', type: [WorkflowStepDto] })
+    @IsArray()
+    @ValidateNested({ each: true })
+    @Type(() => WorkflowStepDto)
     @IsOptional()
-    steps?: Record<string, any>;
+    steps?: WorkflowStepDto[];
 
     @ApiPropertyOptional({ description: 'Enable/disable workflow' })
     @IsBoolean()
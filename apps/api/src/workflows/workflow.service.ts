@@ -17,7 +17,7 @@ export class WorkflowService {
     ) { }
 
     async getTemplates() {
-        return Object.values(WORKFLOW_TEMPLATES);
+        return Object.entries(WORKFLOW_TEMPLATES).map(([id, template]) => ({ id, ...template }));
     }
 
     async createWorkflow(workspaceId: string, userId: string, dto: CreateWorkflowDto) {
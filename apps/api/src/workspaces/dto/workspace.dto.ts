@@ -0,0 +1,53 @@
+import { IsInt, IsNumber, IsOptional, IsPositive, IsString, Matches, Min } from 'class-validator';
+import { ApiProperty, ApiPropertyOptional } from '@nestjs/swagger';
+
+export class CreateWorkspaceDto {
+  @ApiProperty({ description: 'Workspace name' })
+  @IsString()
+  @Matches(/\S/, { message: 'name must not be blank' })
+  name!: string;
+
+  @ApiPropertyOptional({ description: 'Affected users at which an alert is high impact' })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  highImpactUserThreshold?: number;
+
+  @ApiPropertyOptional({ description: 'Affected users at which an alert is medium impact' })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  mediumImpactUserThreshold?: number;
+
+  @ApiPropertyOptional({ description: 'Events per hour at which an alert is high velocity' })
+  @IsOptional()
+  @IsNumber()
+  @IsPositive()
+  highVelocityThreshold?: number;
+}
+
+export class UpdateWorkspaceDto {
+  @ApiPropertyOptional({ description: 'Workspace name' })
+  @IsOptional()
+  @IsString()
+  @Matches(/\S/, { message: 'name must not be blank' })
+  name?: string;
+
+  @ApiPropertyOptional({ description: 'Affected users at which an alert is high impact' })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  highImpactUserThreshold?: number;
+
+  @ApiPropertyOptional({ description: 'Affected users at which an alert is medium impact' })
+  @IsOptional()
+  @IsInt()
+  @Min(1)
+  mediumImpactUserThreshold?: number;
+
+  @ApiPropertyOptional({ description: 'Events per hour at which an alert is high velocity' })
+  @IsOptional()
+  @IsNumber()
+  @IsPositive()
+  highVelocityThreshold?: number;
+}
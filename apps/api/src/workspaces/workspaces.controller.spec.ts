@@ -0,0 +1,72 @@
+import { ExecutionContext } from '@nestjs/common';
+import { ROUTE_ARGS_METADATA } from '@nestjs/common/constants';
+import { plainToInstance } from 'class-transformer';
+import { validate } from 'class-validator';
+import { WorkspacesController } from './workspaces.controller';
+import { WorkspacesService } from './workspaces.service';
+import { CreateWorkspaceDto, UpdateWorkspaceDto } from './dto/workspace.dto';
+
+const options = { whitelist: true, forbidNonWhitelisted: true };
+
+const errorsFor = async (dtoClass: any, body: Record<string, unknown>) =>
+  (await validate(plainToInstance(dtoClass, body), options)).map((error) => error.property);
+
+describe('CreateWorkspaceDto', () => {
+  it('accepts the payload sent by the Terraform provider', async () => {
+    await expect(
+      errorsFor(CreateWorkspaceDto, {
+        name: 'Staging',
+        highImpactUserThreshold: 100,
+        mediumImpactUserThreshold: 20,
+        highVelocityThreshold: 2.5,
+      }),
+    ).resolves.toEqual([]);
+  });
+
+  it.each([
+    [{}, 'name'],
+    [{ name: '  ' }, 'name'],
+    [{ name: 'Staging', highImpactUserThreshold: 1.5 }, 'highImpactUserThreshold'],
+    [{ name: 'Staging', mediumImpactUserThreshold: 0 }, 'mediumImpactUserThreshold'],
+    [{ name: 'Staging', highVelocityThreshold: 0 }, 'highVelocityThreshold'],
+    [{ name: 'Staging', parentWorkspaceId: 'ws-9' }, 'parentWorkspaceId'],
+  ])('rejects %p', async (body, property) => {
+    await expect(errorsFor(CreateWorkspaceDto, body)).resolves.toEqual([property]);
+  });
+});
+
+describe('UpdateWorkspaceDto', () => {
+  it('accepts a partial update', async () => {
+    await expect(errorsFor(UpdateWorkspaceDto, { highVelocityThreshold: 10 })).resolves.toEqual([]);
+  });
+
+  it('rejects a blank name', async () => {
+    await expect(errorsFor(UpdateWorkspaceDto, { name: '' })).resolves.toEqual(['name']);
+  });
+});
+
+describe('WorkspacesController', () => {
+  const workspacesService = {
+    deleteChildWorkspace: jest.fn(),
+  } as unknown as WorkspacesService;
+  const controller = new WorkspacesController(workspacesService);
+
+  // Runs the custom parameter decorator at the given position of a handler.
+  const resolveParam = (handler: string, index: number, request: any) => {
+    const args = Reflect.getMetadata(ROUTE_ARGS_METADATA, WorkspacesController, handler);
+    const param: any = Object.values(args).find((arg: any) => arg.index === index && arg.factory);
+    const context = {
+      switchToHttp: () => ({ getRequest: () => request }),
+    } as unknown as ExecutionContext;
+    return param.factory(param.data, context);
+  };
+
+  it('passes the API key actor to deleteChildWorkspace', async () => {
+    const user = { authType: 'apiKey', workspaceId: 'ws-1', apiKeyId: 'key-1' };
+
+    expect(resolveParam('deleteWorkspace', 1, { user })).toBe(user);
+
+    await controller.deleteWorkspace('ws-1', user, 'ws-2');
+    expect(workspacesService.deleteChildWorkspace).toHaveBeenCalledWith('ws-1', 'ws-2', user);
+  });
+});
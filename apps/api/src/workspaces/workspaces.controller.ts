@@ -1,11 +1,23 @@
-import { Controller, Get, UseGuards, Patch, Delete, Param, Body } from '@nestjs/common';
+import {
+  Controller,
+  Get,
+  Post,
+  UseGuards,
+  Patch,
+  Delete,
+  Param,
+  Body,
+} from '@nestjs/common';
 import { ApiBearerAuth, ApiTags, ApiOperation, ApiResponse, ApiParam } from '@nestjs/swagger';
 import { ApiOrClerkAuthGuard } from '../auth/api-or-clerk-auth.guard';
 import { RolesGuard } from '../common/guards/roles.guard';
 import { Roles } from '../common/decorators/roles.decorator';
 import { WorkspaceId } from '../common/decorators/workspace-id.decorator';
 import { DbUser } from '../common/decorators/db-user.decorator';
+import { CurrentUser } from '../common/decorators/current-user.decorator';
+import { RequestActor } from '../api-keys/api-key.service';
 import { WorkspacesService } from './workspaces.service';
+import { CreateWorkspaceDto, UpdateWorkspaceDto } from './dto/workspace.dto';
 import { WorkspaceRole, User } from '@signalcraft/database';
 
 @ApiTags('Workspaces')
@@ -67,4 +79,62 @@ export class WorkspacesController {
   ) {
     return this.workspacesService.removeMember(workspaceId, userId, actor.id);
   }
+
+  @ApiBearerAuth()
+  @UseGuards(ApiOrClerkAuthGuard, RolesGuard)
+  @Roles('ADMIN' as any, 'OWNER' as any)
+  @Post()
+  @ApiOperation({
+    summary: 'Create a workspace',
+    description:
+      'Creates a workspace owned by the current one. The response includes a bootstrap API key for the new workspace, which is never returned again.',
+  })
+  @ApiResponse({ status: 201, description: 'Workspace created' })
+  async createWorkspace(
+    @WorkspaceId() workspaceId: string,
+    @CurrentUser() user: RequestActor,
+    @Body() body: CreateWorkspaceDto,
+  ) {
+    return this.workspacesService.createChildWorkspace(workspaceId, user, body);
+  }
+
+  @ApiBearerAuth()
+  @UseGuards(ApiOrClerkAuthGuard)
+  @Get(':id')
+  @ApiOperation({ summary: 'Get a workspace created by the current workspace' })
+  @ApiParam({ name: 'id', description: 'Workspace ID' })
+  async getWorkspace(@WorkspaceId() workspaceId: string, @Param('id') id: string) {
+    return this.workspacesService.getChildWorkspace(workspaceId, id);
+  }
+
+  @ApiBearerAuth()
+  @UseGuards(ApiOrClerkAuthGuard, RolesGuard)
+  @Roles('ADMIN' as any, 'OWNER' as any)
+  @Patch(':id')
+  @ApiOperation({ summary: 'Update a workspace created by the current workspace' })
+  @ApiParam({ name: 'id', description: 'Workspace ID' })
+  async updateWorkspace(
+    @WorkspaceId() workspaceId: string,
+    @Param('id') id: string,
+    @Body() body: UpdateWorkspaceDto,
+  ) {
+    return this.workspacesService.updateChildWorkspace(workspaceId, id, body);
+  }
+
+  @ApiBearerAuth()
+  @UseGuards(ApiOrClerkAuthGuard, RolesGuard)
+  @Roles('ADMIN' as any, 'OWNER' as any)
+  @Delete(':id')
+  @ApiOperation({
+    summary: 'Delete a workspace created by the current workspace',
+    description: 'Soft deletes the workspace and revokes all of its API keys.',
+  })
+  @ApiParam({ name: 'id', description: 'Workspace ID' })
+  async deleteWorkspace(
+    @WorkspaceId() workspaceId: string,
+    @CurrentUser() user: RequestActor,
+    @Param('id') id: string,
+  ) {
+    return this.workspacesService.deleteChildWorkspace(workspaceId, id, user);
+  }
 }
@@ -0,0 +1,83 @@
+import { BadRequestException } from '@nestjs/common';
+import { prisma } from '@signalcraft/database';
+import { WorkspacesService } from './workspaces.service';
+
+jest.mock('@signalcraft/database', () => ({
+  prisma: {
+    $transaction: jest.fn(),
+    workspace: { findFirst: jest.fn(), update: jest.fn() },
+    apiKey: { updateMany: jest.fn() },
+  },
+}));
+
+describe('WorkspacesService child workspaces', () => {
+  const auditService = { log: jest.fn() };
+  const apiKeyService = { resolveCreatorId: jest.fn(), createApiKey: jest.fn() };
+  const service = new WorkspacesService(auditService as any, apiKeyService as any);
+  const prismaClient = prisma as any;
+  const actor = { apiKeyId: 'key-1' };
+  const tx = {
+    workspace: { create: jest.fn() },
+    serviceAccount: { create: jest.fn() },
+  };
+
+  beforeEach(() => {
+    jest.clearAllMocks();
+    apiKeyService.resolveCreatorId.mockResolvedValue('user-1');
+    prismaClient.$transaction.mockImplementation((arg: any) =>
+      typeof arg === 'function' ? arg(tx) : Promise.all(arg),
+    );
+    tx.workspace.create.mockResolvedValue({ id: 'ws-2', name: 'Staging' });
+    tx.serviceAccount.create.mockResolvedValue({ id: 'sa-1' });
+  });
+
+  it('creates the bootstrap API key in the workspace transaction', async () => {
+    apiKeyService.createApiKey.mockResolvedValue({ id: 'key-2', prefix: 'sk_live_1234', key: 'sk_live_secret' });
+
+    await expect(service.createChildWorkspace('ws-1', actor, { name: 'Staging' })).resolves.toEqual({
+      id: 'ws-2',
+      name: 'Staging',
+      bootstrapApiKey: { id: 'key-2', prefix: 'sk_live_1234', key: 'sk_live_secret' },
+    });
+    expect(apiKeyService.createApiKey).toHaveBeenCalledWith(
+      { workspaceId: 'ws-2', createdBy: 'user-1', name: 'bootstrap', serviceAccountId: 'sa-1' },
+      tx,
+    );
+    expect(auditService.log).toHaveBeenCalledWith(
+      expect.objectContaining({ workspaceId: 'ws-1', userId: 'user-1', action: 'CREATE_WORKSPACE' }),
+    );
+  });
+
+  it('fails the whole creation when the API key cannot be created', async () => {
+    apiKeyService.createApiKey.mockRejectedValue(new Error('unique constraint'));
+
+    await expect(service.createChildWorkspace('ws-1', actor, { name: 'Staging' })).rejects.toThrow(
+      'unique constraint',
+    );
+    expect(auditService.log).not.toHaveBeenCalled();
+  });
+
+  it('attributes a deletion made with an API key to the key creator', async () => {
+    prismaClient.workspace.findFirst.mockResolvedValue({ id: 'ws-2', name: 'Staging' });
+
+    await expect(service.deleteChildWorkspace('ws-1', 'ws-2', actor)).resolves.toEqual({ success: true });
+    expect(apiKeyService.resolveCreatorId).toHaveBeenCalledWith(actor);
+    expect(prismaClient.apiKey.updateMany).toHaveBeenCalledWith({
+      where: { workspaceId: 'ws-2', revokedAt: null },
+      data: { revokedAt: expect.any(Date) },
+    });
+    expect(auditService.log).toHaveBeenCalledWith(
+      expect.objectContaining({ workspaceId: 'ws-1', userId: 'user-1', action: 'DELETE_WORKSPACE', resourceId: 'ws-2' }),
+    );
+  });
+
+  it('deletes nothing without a user to attribute the deletion to', async () => {
+    prismaClient.workspace.findFirst.mockResolvedValue({ id: 'ws-2', name: 'Staging' });
+    apiKeyService.resolveCreatorId.mockRejectedValue(new BadRequestException('Missing user context'));
+
+    await expect(service.deleteChildWorkspace('ws-1', 'ws-2', undefined)).rejects.toBeInstanceOf(
+      BadRequestException,
+    );
+    expect(prismaClient.$transaction).not.toHaveBeenCalled();
+  });
+});
@@ -1,12 +1,27 @@
-import { Injectable } from '@nestjs/common';
+import { Injectable, NotFoundException } from '@nestjs/common';
 import { prisma } from '@signalcraft/database';
 import { AuditService } from '../audit/audit.service';
+import { ApiKeyService, RequestActor } from '../api-keys/api-key.service';
+import { CreateWorkspaceDto, UpdateWorkspaceDto } from './dto/workspace.dto';
+
+const childWorkspaceSelect = {
+  id: true,
+  name: true,
+  parentWorkspaceId: true,
+  createdAt: true,
+  highImpactUserThreshold: true,
+  mediumImpactUserThreshold: true,
+  highVelocityThreshold: true,
+};
 
 @Injectable()
 export class WorkspacesService {
   private readonly prismaClient = prisma as any;
 
-  constructor(private readonly auditService: AuditService) {}
+  constructor(
+    private readonly auditService: AuditService,
+    private readonly apiKeyService: ApiKeyService,
+  ) {}
 
   async getByClerkId(clerkId: string) {
     const user = await prisma.user.findUnique({
@@ -82,4 +97,140 @@ export class WorkspacesService {
 
     return user;
   }
+
+  /**
+   * Create a workspace owned by the caller's workspace, together with a
+   * service account and a bootstrap API key for managing it. All three are
+   * created in one transaction, and the key is only returned here.
+   */
+  async createChildWorkspace(
+    parentWorkspaceId: string,
+    actor: RequestActor | undefined,
+    data: CreateWorkspaceDto,
+  ) {
+    const createdBy = await this.apiKeyService.resolveCreatorId(actor);
+
+    const { workspace, apiKey } = await this.prismaClient.$transaction(async (tx: any) => {
+      const workspace = await tx.workspace.create({
+        data: {
+          name: data.name,
+          parentWorkspaceId,
+          ...(data.highImpactUserThreshold !== undefined && {
+            highImpactUserThreshold: data.highImpactUserThreshold,
+          }),
+          ...(data.mediumImpactUserThreshold !== undefined && {
+            mediumImpactUserThreshold: data.mediumImpactUserThreshold,
+          }),
+          ...(data.highVelocityThreshold !== undefined && {
+            highVelocityThreshold: data.highVelocityThreshold,
+          }),
+        },
+        select: childWorkspaceSelect,
+      });
+      const serviceAccount = await tx.serviceAccount.create({
+        data: {
+          workspaceId: workspace.id,
+          name: 'bootstrap',
+          description: 'Created with the workspace for automation',
+          createdBy,
+        },
+      });
+      const apiKey = await this.apiKeyService.createApiKey(
+        {
+          workspaceId: workspace.id,
+          createdBy,
+          name: 'bootstrap',
+          serviceAccountId: serviceAccount.id,
+        },
+        tx,
+      );
+      return { workspace, apiKey };
+    });
+
+    await this.auditService.log({
+      workspaceId: parentWorkspaceId,
+      userId: createdBy,
+      action: 'CREATE_WORKSPACE',
+      resourceType: 'Workspace',
+      resourceId: workspace.id,
+      metadata: { name: workspace.name },
+    });
+
+    return {
+      ...workspace,
+      bootstrapApiKey: { id: apiKey.id, prefix: apiKey.prefix, key: apiKey.key },
+    };
+  }
+
+  async getChildWorkspace(parentWorkspaceId: string, workspaceId: string) {
+    const workspace = await this.prismaClient.workspace.findFirst({
+      where: { id: workspaceId, parentWorkspaceId, deletedAt: null },
+      select: childWorkspaceSelect,
+    });
+    if (!workspace) {
+      throw new NotFoundException('Workspace not found');
+    }
+    return workspace;
+  }
+
+  async updateChildWorkspace(
+    parentWorkspaceId: string,
+    workspaceId: string,
+    data: UpdateWorkspaceDto,
+  ) {
+    await this.getChildWorkspace(parentWorkspaceId, workspaceId);
+
+    return this.prismaClient.workspace.update({
+      where: { id: workspaceId },
+      data: {
+        ...(data.name !== undefined && { name: data.name }),
+        ...(data.highImpactUserThreshold !== undefined && {
+          highImpactUserThreshold: data.highImpactUserThreshold,
+        }),
+        ...(data.mediumImpactUserThreshold !== undefined && {
+          mediumImpactUserThreshold: data.mediumImpactUserThreshold,
+        }),
+        ...(data.highVelocityThreshold !== undefined && {
+          highVelocityThreshold: data.highVelocityThreshold,
+        }),
+      },
+      select: childWorkspaceSelect,
+    });
+  }
+
+  /**
+   * Soft delete a child workspace. Its data is kept, but every API key is
+   * revoked so nothing can use it any more.
+   */
+  async deleteChildWorkspace(
+    parentWorkspaceId: string,
+    workspaceId: string,
+    actor: RequestActor | undefined,
+  ) {
+    const workspace = await this.getChildWorkspace(parentWorkspaceId, workspaceId);
+    const deletedBy = await this.apiKeyService.resolveCreatorId(actor);
+    const now = new Date();
+
+    await this.prismaClient.$transaction([
+      this.prismaClient.apiKey.updateMany({
+        where: { workspaceId, revokedAt: null },
+        data: { revokedAt: now },
+      }),
+      this.prismaClient.workspace.update({
+        where: { id: workspaceId },
+        data: { deletedAt: now },
+      }),
+    ]);
+
+    await this.auditService.log({
+      workspaceId: parentWorkspaceId,
+      userId: deletedBy,
+      action: 'DELETE_WORKSPACE',
+      resourceType: 'Workspace',
+      resourceId: workspaceId,
+      metadata: { name: workspace.name },
+    });
+
+    return { success: true };
+  }
 }
@@ -0,0 +1,2 @@
+-- AlterTable: Mark human-curated correlation rules so analysis does not overwrite them
+ALTER TABLE "CorrelationRule" ADD COLUMN "curated" BOOLEAN NOT NULL DEFAULT false;
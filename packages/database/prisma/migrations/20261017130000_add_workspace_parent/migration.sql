@@ -0,0 +1,6 @@
+-- AlterTable: Workspaces created through the API record their parent and are soft deleted
+ALTER TABLE "Workspace" ADD COLUMN "parentWorkspaceId" TEXT,
+ADD COLUMN "deletedAt" TIMESTAMP(3);
+
+-- CreateIndex
+CREATE INDEX "Workspace_parentWorkspaceId_idx" ON "Workspace"("parentWorkspaceId");
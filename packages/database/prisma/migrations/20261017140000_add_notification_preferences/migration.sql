@@ -0,0 +1,21 @@
+-- CreateTable: Notification preferences were kept in memory and shared by every workspace
+CREATE TABLE "NotificationPreference" (
+    "id" TEXT NOT NULL,
+    "workspaceId" TEXT NOT NULL,
+    "defaultChannel" TEXT NOT NULL DEFAULT '#alerts',
+    "quietHoursEnabled" BOOLEAN NOT NULL DEFAULT false,
+    "quietHoursStart" TEXT NOT NULL DEFAULT '22:00',
+    "quietHoursEnd" TEXT NOT NULL DEFAULT '08:00',
+    "escalationEnabled" BOOLEAN NOT NULL DEFAULT true,
+    "escalationMinutes" INTEGER NOT NULL DEFAULT 15,
+    "createdAt" TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updatedAt" TIMESTAMP(3) NOT NULL,
+
+    CONSTRAINT "NotificationPreference_pkey" PRIMARY KEY ("id")
+);
+
+-- CreateIndex
+CREATE UNIQUE INDEX "NotificationPreference_workspaceId_key" ON "NotificationPreference"("workspaceId");
+
+-- AddForeignKey
+ALTER TABLE "NotificationPreference" ADD CONSTRAINT "NotificationPreference_workspaceId_fkey" FOREIGN KEY ("workspaceId") REFERENCES "Workspace"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
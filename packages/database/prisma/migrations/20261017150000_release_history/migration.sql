@@ -0,0 +1,8 @@
+-- AlterTable: Releases recorded with a deployId keep one row per deploy
+ALTER TABLE "Release" ADD COLUMN "deployId" TEXT NOT NULL DEFAULT '';
+
+-- DropIndex
+DROP INDEX "Release_workspaceId_version_environment_project_key";
+
+-- CreateIndex
+CREATE UNIQUE INDEX "Release_workspaceId_version_environment_project_deployId_key" ON "Release"("workspaceId", "version", "environment", "project", "deployId");
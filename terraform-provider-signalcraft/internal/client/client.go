@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,7 +15,11 @@ import (
 type Client struct {
 	BaseURL string
 	APIKey  string
+	WebURL  string
 	HTTP    *http.Client
+
+	ChangeActor  string
+	ChangeSource string
 }
 
 func New(baseURL, apiKey string) *Client {
@@ -44,7 +49,6 @@ func (c *Client) DoJSON(
 	idempotencyKey string,
 	out any,
 ) error {
-	url := c.BaseURL + path
 	var payload io.Reader
 	if body != nil {
 		data, err := json.Marshal(body)
@@ -54,37 +58,72 @@ func (c *Client) DoJSON(
 		payload = bytes.NewReader(data)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, url, payload)
+	res, err := c.do(ctx, method, path, payload, idempotencyKey, "application/json")
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(res.Body)
+	if err := decoder.Decode(out); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetText(ctx context.Context, path string) (string, error) {
+	res, err := c.do(ctx, http.MethodGet, path, nil, "", "*/*")
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (c *Client) do(
+	ctx context.Context,
+	method string,
+	path string,
+	payload io.Reader,
+	idempotencyKey string,
+	accept string,
+) (*http.Response, error) {
+	url := c.BaseURL + path
+	req, err := http.NewRequestWithContext(ctx, method, url, payload)
+	if err != nil {
+		return nil, err
+	}
 
 	req.Header.Set("Authorization", "Bearer "+c.APIKey)
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept", accept)
 	if idempotencyKey != "" {
 		req.Header.Set("Idempotency-Key", idempotencyKey)
 	}
 
 	res, err := c.HTTP.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer res.Body.Close()
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		defer res.Body.Close()
 		bodyBytes, _ := io.ReadAll(res.Body)
-		return &HTTPError{StatusCode: res.StatusCode, Body: string(bodyBytes)}
-	}
-
-	if out == nil {
-		return nil
+		return nil, &HTTPError{StatusCode: res.StatusCode, Body: string(bodyBytes)}
 	}
 
-	decoder := json.NewDecoder(res.Body)
-	if err := decoder.Decode(out); err != nil {
-		return err
-	}
-
-	return nil
+	return res, nil
 }
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -20,6 +21,10 @@ type signalcraftProvider struct{}
 type providerModel struct {
 	BaseURL types.String `tfsdk:"base_url"`
 	APIKey  types.String `tfsdk:"api_key"`
+	WebURL  types.String `tfsdk:"web_url"`
+
+	ChangeActor  types.String `tfsdk:"change_actor"`
+	ChangeSource types.String `tfsdk:"change_source"`
 }
 
 func New() provider.Provider {
@@ -42,6 +47,18 @@ func (p *signalcraftProvider) Schema(_ context.Context, _ provider.SchemaRequest
 				Sensitive:   true,
 				Description: "SignalCraft API key. Defaults to SIGNALCRAFT_API_KEY.",
 			},
+			"web_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "SignalCraft web app URL used to build public links. Defaults to SIGNALCRAFT_WEB_URL or http://localhost:3000.",
+			},
+			"change_actor": schema.StringAttribute{
+				Optional:    true,
+				Description: "Actor recorded on change events. Defaults to SIGNALCRAFT_CHANGE_ACTOR, then USER.",
+			},
+			"change_source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Source recorded on change events. Defaults to SIGNALCRAFT_CHANGE_SOURCE or terraform.",
+			},
 		},
 	}
 }
@@ -77,11 +94,39 @@ func (p *signalcraftProvider) Configure(
 		return
 	}
 
+	webURL := config.WebURL.ValueString()
+	if webURL == "" {
+		webURL = os.Getenv("SIGNALCRAFT_WEB_URL")
+	}
+	if webURL == "" {
+		webURL = "http://localhost:3000"
+	}
+
+	changeActor := config.ChangeActor.ValueString()
+	if changeActor == "" {
+		changeActor = os.Getenv("SIGNALCRAFT_CHANGE_ACTOR")
+	}
+	if changeActor == "" {
+		changeActor = os.Getenv("USER")
+	}
+
+	changeSource := config.ChangeSource.ValueString()
+	if changeSource == "" {
+		changeSource = os.Getenv("SIGNALCRAFT_CHANGE_SOURCE")
+	}
+	if changeSource == "" {
+		changeSource = "terraform"
+	}
+
 	tflog.Debug(ctx, "Configuring SignalCraft client", map[string]any{
 		"base_url": baseURL,
+		"web_url":  webURL,
 	})
 
 	apiClient := client.New(baseURL, apiKey)
+	apiClient.WebURL = strings.TrimRight(webURL, "/")
+	apiClient.ChangeActor = changeActor
+	apiClient.ChangeSource = changeSource
 	resp.DataSourceData = apiClient
 	resp.ResourceData = apiClient
 }
@@ -94,7 +139,35 @@ func (p *signalcraftProvider) Resources(_ context.Context) []func() resource.Res
 		resources.NewRoutingRuleResource,
 		resources.NewEscalationPolicyResource,
 		resources.NewTeamResource,
+		resources.NewTeamMembershipResource,
 		resources.NewScheduleResource,
+		resources.NewAlertPolicyResource,
+		resources.NewOnCallRotationResource,
+		resources.NewOnCallOverrideResource,
+		resources.NewPagingPolicyResource,
+		resources.NewUptimeCheckResource,
+		resources.NewStatusPageResource,
+		resources.NewStatusPageMaintenanceResource,
+		resources.NewWebhookIntegrationResource,
+		resources.NewServiceAccountResource,
+		resources.NewServiceAccountKeyResource,
+		resources.NewAPIKeyResource,
+		resources.NewRemediationWorkflowResource,
+		resources.NewDashboardResource,
+		resources.NewRolePermissionsResource,
+		resources.NewSamlConfigResource,
+		resources.NewNotificationSettingsResource,
+		resources.NewTwilioSettingsResource,
+		resources.NewReleaseResource,
+		resources.NewChangeEventResource,
+		resources.NewSlackIntegrationResource,
+		resources.NewTeamsIntegrationResource,
+		resources.NewDiscordIntegrationResource,
+		resources.NewJiraIntegrationResource,
+		resources.NewPagerDutyIntegrationResource,
+		resources.NewOpsgenieIntegrationResource,
+		resources.NewEmailIntegrationResource,
+		resources.NewCorrelationRuleResource,
 	}
 }
 
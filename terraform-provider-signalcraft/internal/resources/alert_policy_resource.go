@@ -0,0 +1,375 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+const alertPolicyExternalImportPrefix = "external:"
+
+type alertPolicyResource struct {
+	client *client.Client
+}
+
+type alertPolicyModel struct {
+	ID         types.String                `tfsdk:"id"`
+	Name       types.String                `tfsdk:"name"`
+	ExternalID types.String                `tfsdk:"external_id"`
+	Severity   types.String                `tfsdk:"severity"`
+	RoutingKey types.String                `tfsdk:"routing_key"`
+	Conditions []alertPolicyConditionModel `tfsdk:"condition"`
+}
+
+type alertPolicyConditionModel struct {
+	Type     types.String  `tfsdk:"type"`
+	Metric   types.String  `tfsdk:"metric"`
+	Operator types.String  `tfsdk:"operator"`
+	Value    types.Float64 `tfsdk:"value"`
+}
+
+type alertPolicyPayload struct {
+	Name       string                      `json:"name"`
+	ExternalID *string                     `json:"externalId,omitempty"`
+	Severity   string                      `json:"severity"`
+	RoutingKey string                      `json:"routingKey"`
+	Conditions []alertPolicyConditionEntry `json:"conditions"`
+}
+
+type alertPolicyConditionEntry struct {
+	Type     string   `json:"type"`
+	Metric   *string  `json:"metric,omitempty"`
+	Operator *string  `json:"operator,omitempty"`
+	Value    *float64 `json:"value,omitempty"`
+}
+
+type alertPolicyResponse struct {
+	ID         string                      `json:"id"`
+	Name       string                      `json:"name"`
+	ExternalID *string                     `json:"externalId"`
+	Severity   string                      `json:"severity"`
+	RoutingKey string                      `json:"routingKey"`
+	Conditions []alertPolicyConditionEntry `json:"conditions"`
+}
+
+func NewAlertPolicyResource() resource.Resource {
+	return &alertPolicyResource{}
+}
+
+func (r *alertPolicyResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_alert_policy"
+}
+
+func (r *alertPolicyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"external_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Stable external identifier. The k8s operator uses namespace/name.",
+			},
+			"severity": schema.StringAttribute{
+				Required: true,
+			},
+			"routing_key": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"condition": schema.ListNestedBlock{
+				Description: "Ordered list of conditions evaluated by the policy.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required: true,
+						},
+						"metric": schema.StringAttribute{
+							Optional: true,
+						},
+						"operator": schema.StringAttribute{
+							Optional: true,
+						},
+						"value": schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *alertPolicyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *alertPolicyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan alertPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := buildAlertPolicyPayload(plan)
+
+	var created alertPolicyResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/alert-policies", payload, uuid.NewString(), &created)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state, diags := r.readAlertPolicyState(ctx, created.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *alertPolicyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state alertPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp alertPolicyResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/alert-policies/%s", state.ID.ValueString()),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState := flattenAlertPolicy(apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *alertPolicyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan alertPolicyModel
+	var state alertPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := buildAlertPolicyPayload(plan)
+	payload.ExternalID = nil
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("/api/alert-policies/%s", state.ID.ValueString()),
+		payload,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState, diags := r.readAlertPolicyState(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *alertPolicyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state alertPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/alert-policies/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *alertPolicyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	if !strings.HasPrefix(req.ID, alertPolicyExternalImportPrefix) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	externalID := strings.TrimPrefix(req.ID, alertPolicyExternalImportPrefix)
+	if externalID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected a policy ID or external:<external_id>.",
+		)
+		return
+	}
+
+	var policies []alertPolicyResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/alert-policies", nil, "", &policies)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	for _, policy := range policies {
+		if policy.ExternalID != nil && *policy.ExternalID == externalID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), policy.ID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Alert policy not found",
+		fmt.Sprintf("No alert policy with external ID %q exists in this workspace.", externalID),
+	)
+}
+
+func (r *alertPolicyResource) readAlertPolicyState(
+	ctx context.Context,
+	policyID string,
+) (alertPolicyModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp alertPolicyResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/alert-policies/%s", policyID),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return alertPolicyModel{}, diags
+	}
+
+	return flattenAlertPolicy(apiResp), diags
+}
+
+func buildAlertPolicyPayload(plan alertPolicyModel) alertPolicyPayload {
+	var externalID *string
+	if !plan.ExternalID.IsNull() && !plan.ExternalID.IsUnknown() {
+		value := plan.ExternalID.ValueString()
+		externalID = &value
+	}
+
+	conditions := make([]alertPolicyConditionEntry, 0, len(plan.Conditions))
+	for _, condition := range plan.Conditions {
+		conditions = append(conditions, alertPolicyConditionEntry{
+			Type:     condition.Type.ValueString(),
+			Metric:   condition.Metric.ValueStringPointer(),
+			Operator: condition.Operator.ValueStringPointer(),
+			Value:    condition.Value.ValueFloat64Pointer(),
+		})
+	}
+
+	return alertPolicyPayload{
+		Name:       plan.Name.ValueString(),
+		ExternalID: externalID,
+		Severity:   plan.Severity.ValueString(),
+		RoutingKey: plan.RoutingKey.ValueString(),
+		Conditions: conditions,
+	}
+}
+
+func flattenAlertPolicy(apiResp alertPolicyResponse) alertPolicyModel {
+	conditions := make([]alertPolicyConditionModel, 0, len(apiResp.Conditions))
+	for _, condition := range apiResp.Conditions {
+		conditions = append(conditions, alertPolicyConditionModel{
+			Type:     types.StringValue(condition.Type),
+			Metric:   types.StringPointerValue(condition.Metric),
+			Operator: types.StringPointerValue(condition.Operator),
+			Value:    types.Float64PointerValue(condition.Value),
+		})
+	}
+
+	return alertPolicyModel{
+		ID:         types.StringValue(apiResp.ID),
+		Name:       types.StringValue(apiResp.Name),
+		ExternalID: types.StringPointerValue(apiResp.ExternalID),
+		Severity:   types.StringValue(apiResp.Severity),
+		RoutingKey: types.StringValue(apiResp.RoutingKey),
+		Conditions: conditions,
+	}
+}
@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlertPolicyPayloadRoundTrip(t *testing.T) {
+	plan := alertPolicyModel{
+		ID:         types.StringUnknown(),
+		Name:       types.StringValue("High error rate"),
+		ExternalID: types.StringValue("payments/high-error-rate"),
+		Severity:   types.StringValue("HIGH"),
+		RoutingKey: types.StringValue("payments"),
+		Conditions: []alertPolicyConditionModel{
+			{
+				Type:     types.StringValue("threshold"),
+				Metric:   types.StringValue("error_rate"),
+				Operator: types.StringValue(">"),
+				Value:    types.Float64Value(0.05),
+			},
+			{
+				Type:     types.StringValue("new_issue"),
+				Metric:   types.StringNull(),
+				Operator: types.StringNull(),
+				Value:    types.Float64Null(),
+			},
+		},
+	}
+
+	payload := buildAlertPolicyPayload(plan)
+	body, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var apiResp alertPolicyResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		t.Fatal(err)
+	}
+	apiResp.ID = "policy_1"
+
+	state := flattenAlertPolicy(apiResp)
+	if state.ID.ValueString() != "policy_1" || state.ExternalID.ValueString() != "payments/high-error-rate" {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+	if len(state.Conditions) != 2 {
+		t.Fatalf("expected 2 conditions, got %d", len(state.Conditions))
+	}
+	for i, condition := range state.Conditions {
+		want := plan.Conditions[i]
+		if !condition.Type.Equal(want.Type) || !condition.Metric.Equal(want.Metric) ||
+			!condition.Operator.Equal(want.Operator) || !condition.Value.Equal(want.Value) {
+			t.Fatalf("condition %d: got %+v, want %+v", i, condition, want)
+		}
+	}
+}
+
+func TestAlertPolicyImportByExternalID(t *testing.T) {
+	externalID := "payments/high-error-rate"
+	r := &alertPolicyResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]alertPolicyResponse{
+			{ID: "policy_1"},
+			{ID: "policy_2", ExternalID: &externalID},
+		})
+	})}
+
+	importState := func(id string) resource.ImportStateResponse {
+		resp := resource.ImportStateResponse{State: testState(t, r, nil)}
+		r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, &resp)
+		return resp
+	}
+
+	resp := importState("external:" + externalID)
+	requireNoDiags(t, resp.Diagnostics)
+	var id types.String
+	requireNoDiags(t, resp.State.GetAttribute(context.Background(), path.Root("id"), &id))
+	if id.ValueString() != "policy_2" {
+		t.Fatalf("expected policy_2, got %q", id.ValueString())
+	}
+
+	requireErrorSummary(t, importState("external:other/policy").Diagnostics, "Alert policy not found")
+	requireErrorSummary(t, importState("external:").Diagnostics, "Invalid Import ID")
+}
@@ -0,0 +1,378 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type apiKeyResource struct {
+	client *client.Client
+}
+
+type apiKeyModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+	ValidFor     types.String `tfsdk:"valid_for"`
+	RotateBefore types.String `tfsdk:"rotate_before"`
+	Prefix       types.String `tfsdk:"prefix"`
+	Key          types.String `tfsdk:"key"`
+}
+
+type apiKeyPayload struct {
+	Name      string  `json:"name"`
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+}
+
+type apiKeyResponse struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Prefix    string  `json:"prefix"`
+	Key       *string `json:"key"`
+	ExpiresAt *string `json:"expiresAt"`
+}
+
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+func (r *apiKeyResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_api_key"
+}
+
+func (r *apiKeyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Workspace API key. Destroying the resource revokes the key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Fixed expiry. Conflicts with valid_for, which sets it when the key is issued.",
+				Validators:  []validator.String{rfc3339Validator{}},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"valid_for": schema.StringAttribute{
+				Optional:    true,
+				Description: "Lifetime such as \"2160h\". Each key issued, including rotated replacements, expires this long after it is created.",
+				Validators:  []validator.String{durationValidator{}},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Duration such as \"720h\". The key is replaced once expires_at is less than this far away. Requires valid_for.",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"prefix": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *apiKeyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *apiKeyResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config apiKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ValidFor.IsNull() && !config.ExpiresAt.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("valid_for"),
+			"Conflicting Expiry",
+			"Set either valid_for or expires_at, not both.",
+		)
+	}
+
+	if config.RotateBefore.IsNull() || config.RotateBefore.IsUnknown() {
+		return
+	}
+
+	if config.ValidFor.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate_before"),
+			"Missing Lifetime",
+			"rotate_before requires valid_for.",
+		)
+		return
+	}
+	if config.ValidFor.IsUnknown() {
+		return
+	}
+
+	validFor, validErr := time.ParseDuration(config.ValidFor.ValueString())
+	rotateBefore, rotateErr := time.ParseDuration(config.RotateBefore.ValueString())
+	if validErr == nil && rotateErr == nil && rotateBefore >= validFor {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotate_before"),
+			"Invalid Rotation Window",
+			fmt.Sprintf(
+				"rotate_before (%s) must be shorter than valid_for (%s), or every new key is due for rotation at once.",
+				config.RotateBefore.ValueString(),
+				config.ValidFor.ValueString(),
+			),
+		)
+	}
+}
+
+func (r *apiKeyResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan apiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		expiresAt, err := time.Parse(time.RFC3339, plan.ExpiresAt.ValueString())
+		if plan.ValidFor.IsNull() && err == nil && !expiresAt.After(time.Now()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Expiry In The Past",
+				fmt.Sprintf("expires_at (%s) has already passed, so the key would be issued expired.", plan.ExpiresAt.ValueString()),
+			)
+		}
+		return
+	}
+
+	var state apiKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.RotateBefore.IsNull() || plan.RotateBefore.IsUnknown() ||
+		plan.ValidFor.IsNull() || plan.ValidFor.IsUnknown() {
+		return
+	}
+
+	rotateBefore, err := time.ParseDuration(plan.RotateBefore.ValueString())
+	if err != nil {
+		return
+	}
+	expiresAt, err := time.Parse(time.RFC3339, state.ExpiresAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	if time.Now().Add(rotateBefore).Before(expiresAt) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+	resp.Diagnostics.AddWarning(
+		"API Key Due For Rotation",
+		fmt.Sprintf(
+			"API key %s expires at %s, within rotate_before (%s). It will be replaced by a key valid for %s.",
+			state.Prefix.ValueString(),
+			state.ExpiresAt.ValueString(),
+			plan.RotateBefore.ValueString(),
+			plan.ValidFor.ValueString(),
+		),
+	)
+}
+
+func (r *apiKeyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan apiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := apiKeyPayload{Name: plan.Name.ValueString()}
+	if !plan.ExpiresAt.IsUnknown() {
+		payload.ExpiresAt = plan.ExpiresAt.ValueStringPointer()
+	}
+	if !plan.ValidFor.IsNull() {
+		validFor, err := time.ParseDuration(plan.ValidFor.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("valid_for"), "Invalid Duration", err.Error())
+			return
+		}
+		expiresAt := time.Now().Add(validFor).UTC().Format(time.RFC3339)
+		payload.ExpiresAt = &expiresAt
+		plan.ExpiresAt = types.StringValue(expiresAt)
+	}
+
+	var apiResp apiKeyResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/api-keys", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state := flattenAPIKey(apiResp, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *apiKeyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state apiKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []apiKeyResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/api-keys", nil, "", &keys)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	for _, key := range keys {
+		if key.ID == state.ID.ValueString() {
+			newState := flattenAPIKey(key, state)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *apiKeyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan apiKeyModel
+	var state apiKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.RotateBefore = plan.RotateBefore
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *apiKeyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state apiKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/api-keys/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *apiKeyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func flattenAPIKey(apiResp apiKeyResponse, prior apiKeyModel) apiKeyModel {
+	key := prior.Key
+	if apiResp.Key != nil {
+		key = types.StringValue(*apiResp.Key)
+	}
+	if key.IsUnknown() {
+		key = types.StringNull()
+	}
+
+	return apiKeyModel{
+		ID:           types.StringValue(apiResp.ID),
+		Name:         types.StringValue(apiResp.Name),
+		ExpiresAt:    timestampValue(prior.ExpiresAt, apiResp.ExpiresAt),
+		ValidFor:     prior.ValidFor,
+		RotateBefore: prior.RotateBefore,
+		Prefix:       types.StringValue(apiResp.Prefix),
+		Key:          key,
+	}
+}
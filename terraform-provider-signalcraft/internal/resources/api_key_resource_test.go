@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAPIKeyValidateConfig(t *testing.T) {
+	r := &apiKeyResource{}
+	expiry := types.StringValue("2030-01-01T00:00:00Z")
+
+	cases := []struct {
+		expiresAt, validFor, rotateBefore types.String
+		summary                           string
+	}{
+		{types.StringNull(), types.StringValue("2160h"), types.StringValue("720h"), ""},
+		{expiry, types.StringNull(), types.StringValue("720h"), "Missing Lifetime"},
+		{expiry, types.StringValue("2160h"), types.StringNull(), "Conflicting Expiry"},
+		{types.StringNull(), types.StringValue("720h"), types.StringValue("720h"), "Invalid Rotation Window"},
+	}
+	for _, c := range cases {
+		diags := validateConfig(t, r, testModel(t, r, func(m *apiKeyModel) {
+			m.Name = types.StringValue("automation")
+			m.ExpiresAt = c.expiresAt
+			m.ValidFor = c.validFor
+			m.RotateBefore = c.rotateBefore
+		}))
+		if c.summary == "" {
+			requireNoDiags(t, diags)
+		} else {
+			requireErrorSummary(t, diags, c.summary)
+		}
+	}
+}
+
+func TestAPIKeyModifyPlanRotatesWithinWindow(t *testing.T) {
+	r := &apiKeyResource{}
+	expiringIn := func(d time.Duration) apiKeyModel {
+		return testModel(t, r, func(m *apiKeyModel) {
+			m.ID = types.StringValue("key-1")
+			m.Name = types.StringValue("automation")
+			m.ExpiresAt = types.StringValue(time.Now().Add(d).UTC().Format(time.RFC3339))
+			m.ValidFor = types.StringValue("2160h")
+			m.RotateBefore = types.StringValue("720h")
+			m.Prefix = types.StringValue("sk_live_abcd1234")
+			m.Key = types.StringNull()
+		})
+	}
+
+	key := expiringIn(24 * time.Hour)
+	resp := modifyPlan(t, r, key, key)
+	requireNoDiags(t, resp.Diagnostics)
+	if len(resp.RequiresReplace) != 1 || !resp.RequiresReplace[0].Equal(path.Root("expires_at")) {
+		t.Fatalf("expected replacement on expires_at, got %v", resp.RequiresReplace)
+	}
+
+	var planned types.String
+	requireNoDiags(t, resp.Plan.GetAttribute(context.Background(), path.Root("expires_at"), &planned))
+	if !planned.IsUnknown() {
+		t.Fatalf("expected expires_at to be recomputed, got %s", planned)
+	}
+
+	key = expiringIn(2000 * time.Hour)
+	resp = modifyPlan(t, r, key, key)
+	requireNoDiags(t, resp.Diagnostics)
+	if len(resp.RequiresReplace) != 0 {
+		t.Fatalf("expected no replacement, got %v", resp.RequiresReplace)
+	}
+}
+
+func TestAPIKeyModifyPlanRejectsPastExpiryOnCreate(t *testing.T) {
+	r := &apiKeyResource{}
+	past := types.StringValue(time.Now().Add(-time.Hour).UTC().Format(time.RFC3339))
+
+	resp := modifyPlan(t, r, nil, testModel(t, r, func(m *apiKeyModel) {
+		m.Name = types.StringValue("automation")
+		m.ExpiresAt = past
+	}))
+	requireErrorSummary(t, resp.Diagnostics, "Expiry In The Past")
+}
+
+func TestAPIKeyCreateDerivesExpiryFromValidFor(t *testing.T) {
+	var sent apiKeyPayload
+	r := &apiKeyResource{client: testClient(t, func(w http.ResponseWriter, req *http.Request) {
+		if err := json.NewDecoder(req.Body).Decode(&sent); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		key := "sk_live_secret"
+		_ = json.NewEncoder(w).Encode(apiKeyResponse{
+			ID:        "key-2",
+			Name:      sent.Name,
+			Prefix:    "sk_live_secret",
+			Key:       &key,
+			ExpiresAt: sent.ExpiresAt,
+		})
+	})}
+
+	plan := testModel(t, r, func(m *apiKeyModel) {
+		m.Name = types.StringValue("automation")
+		m.ValidFor = types.StringValue("2160h")
+		m.RotateBefore = types.StringValue("720h")
+	})
+
+	state, diags := createResource(t, r, plan)
+	requireNoDiags(t, diags)
+
+	if sent.ExpiresAt == nil {
+		t.Fatal("expected expiresAt to be sent")
+	}
+	expiresAt, err := time.Parse(time.RFC3339, *sent.ExpiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remaining := time.Until(expiresAt); remaining < 2159*time.Hour || remaining > 2160*time.Hour {
+		t.Fatalf("expected expiry about 2160h away, got %s", remaining)
+	}
+
+	var created apiKeyModel
+	requireNoDiags(t, state.Get(context.Background(), &created))
+	if created.ExpiresAt.ValueString() != *sent.ExpiresAt || created.Key.ValueString() != "sk_live_secret" {
+		t.Fatalf("unexpected state %+v", created)
+	}
+}
@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type changeEventResource struct {
+	client *client.Client
+}
+
+type changeEventModel struct {
+	ID          types.String `tfsdk:"id"`
+	Triggers    types.Map    `tfsdk:"triggers"`
+	Type        types.String `tfsdk:"type"`
+	Title       types.String `tfsdk:"title"`
+	Project     types.String `tfsdk:"project"`
+	Environment types.String `tfsdk:"environment"`
+	Actor       types.String `tfsdk:"actor"`
+	Source      types.String `tfsdk:"source"`
+	Details     types.Map    `tfsdk:"details"`
+	Timestamp   types.String `tfsdk:"timestamp"`
+}
+
+type changeEventPayload struct {
+	Type        string                 `json:"type"`
+	Source      string                 `json:"source"`
+	Title       *string                `json:"title,omitempty"`
+	Project     *string                `json:"project,omitempty"`
+	Environment *string                `json:"environment,omitempty"`
+	Actor       *string                `json:"actor,omitempty"`
+	Details     map[string]interface{} `json:"details"`
+}
+
+type changeEventResponse struct {
+	ID        string  `json:"id"`
+	Timestamp *string `json:"timestamp"`
+}
+
+func NewChangeEventResource() resource.Resource {
+	return &changeEventResource{}
+}
+
+func (r *changeEventResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_change_event"
+}
+
+func (r *changeEventResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Records a change event so infrastructure changes appear next to the alerts they cause. Every change emits a new event; destroying the resource keeps the recorded events.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "Values whose change emits a new event, such as a parameter group hash. Sent as details.triggers.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("infrastructure"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"actor": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Defaults to the provider's change_actor.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Defaults to the provider's change_source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"details": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timestamp": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *changeEventResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *changeEventResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan changeEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Actor.IsUnknown() {
+		actor := types.StringNull()
+		if r.client.ChangeActor != "" {
+			actor = types.StringValue(r.client.ChangeActor)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("actor"), actor)...)
+	}
+	if plan.Source.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source"), r.client.ChangeSource)...)
+	}
+}
+
+func (r *changeEventResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan changeEventModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggers, diags := expandStringMap(ctx, plan.Triggers)
+	resp.Diagnostics.Append(diags...)
+	details, diags := expandStringMap(ctx, plan.Details)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	detailsPayload := make(map[string]interface{}, len(details)+1)
+	for key, value := range details {
+		detailsPayload[key] = value
+	}
+	detailsPayload["triggers"] = triggers
+
+	payload := changeEventPayload{
+		Type:        plan.Type.ValueString(),
+		Source:      plan.Source.ValueString(),
+		Title:       plan.Title.ValueStringPointer(),
+		Project:     plan.Project.ValueStringPointer(),
+		Environment: plan.Environment.ValueStringPointer(),
+		Actor:       plan.Actor.ValueStringPointer(),
+		Details:     detailsPayload,
+	}
+
+	var apiResp changeEventResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/change-events", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(apiResp.ID)
+	plan.Timestamp = types.StringPointerValue(apiResp.Timestamp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *changeEventResource) Read(
+	_ context.Context,
+	_ resource.ReadRequest,
+	_ *resource.ReadResponse,
+) {
+	return
+}
+
+func (r *changeEventResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	resp.Diagnostics.AddError(
+		"Unsupported",
+		"Updating change events is not supported. Every change emits a new event.",
+	)
+}
+
+func (r *changeEventResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+	return
+}
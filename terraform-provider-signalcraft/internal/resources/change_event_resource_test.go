@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+func testChangeEventDeploy(m *changeEventModel) {
+	m.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{"image": types.StringValue("api:1.4.2")})
+	m.Type = types.StringValue("DEPLOY")
+	m.Title = types.StringValue("Deploy api 1.4.2")
+	m.Environment = types.StringValue("production")
+	m.Details = types.MapValueMust(types.StringType, map[string]attr.Value{"region": types.StringValue("eu")})
+}
+
+func TestChangeEventModifyPlanUsesProviderDefaults(t *testing.T) {
+	r := &changeEventResource{client: &client.Client{ChangeActor: "alice", ChangeSource: "terraform"}}
+
+	var plan changeEventModel
+	resp := modifyPlan(t, r, nil, testModel(t, r, testChangeEventDeploy))
+	requireNoDiags(t, resp.Diagnostics)
+	requireNoDiags(t, resp.Plan.Get(context.Background(), &plan))
+	if plan.Actor.ValueString() != "alice" || plan.Source.ValueString() != "terraform" {
+		t.Fatalf("expected the provider defaults, got actor %s and source %s", plan.Actor, plan.Source)
+	}
+
+	resp = modifyPlan(t, r, nil, testModel(t, r, func(m *changeEventModel) {
+		testChangeEventDeploy(m)
+		m.Actor = types.StringValue("deploy-bot")
+	}))
+	requireNoDiags(t, resp.Diagnostics)
+	requireNoDiags(t, resp.Plan.Get(context.Background(), &plan))
+	if plan.Actor.ValueString() != "deploy-bot" {
+		t.Fatalf("expected the configured actor, got %s", plan.Actor)
+	}
+
+	r.client.ChangeActor = ""
+	resp = modifyPlan(t, r, nil, testModel(t, r, testChangeEventDeploy))
+	requireNoDiags(t, resp.Diagnostics)
+	requireNoDiags(t, resp.Plan.Get(context.Background(), &plan))
+	if !plan.Actor.IsNull() {
+		t.Fatalf("expected a null actor without a default, got %s", plan.Actor)
+	}
+}
+
+func TestChangeEventCreateRecordsTriggersInDetails(t *testing.T) {
+	var sent changeEventPayload
+	r := &changeEventResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		timestamp := "2026-10-17T12:00:00.000Z"
+		_ = json.NewEncoder(w).Encode(changeEventResponse{ID: "change_1", Timestamp: &timestamp})
+	})}
+
+	plan := testModel(t, r, func(m *changeEventModel) {
+		testChangeEventDeploy(m)
+		m.Actor = types.StringValue("alice")
+		m.Source = types.StringValue("terraform")
+	})
+	state, diags := createResource(t, r, plan)
+	requireNoDiags(t, diags)
+
+	triggers, ok := sent.Details["triggers"].(map[string]interface{})
+	if !ok || triggers["image"] != "api:1.4.2" || sent.Details["region"] != "eu" {
+		t.Fatalf("unexpected details: %v", sent.Details)
+	}
+
+	var got changeEventModel
+	requireNoDiags(t, state.Get(context.Background(), &got))
+	if got.ID.ValueString() != "change_1" || got.Timestamp.ValueString() != "2026-10-17T12:00:00.000Z" {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
@@ -0,0 +1,217 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type chatWebhookIntegrationResource struct {
+	client     *client.Client
+	typeName   string
+	id         string
+	label      string
+	apiPath    string
+	urlPattern patternValidator
+}
+
+type chatWebhookIntegrationModel struct {
+	ID         types.String `tfsdk:"id"`
+	WebhookURL types.String `tfsdk:"webhook_url"`
+	Status     types.String `tfsdk:"status"`
+}
+
+type chatWebhookIntegrationPayload struct {
+	WebhookURL string `json:"webhookUrl"`
+}
+
+type chatWebhookIntegrationStatusResponse struct {
+	Configured bool    `json:"configured"`
+	Status     *string `json:"status"`
+}
+
+func NewTeamsIntegrationResource() resource.Resource {
+	return &chatWebhookIntegrationResource{
+		typeName: "signalcraft_teams_integration",
+		id:       "teams",
+		label:    "Microsoft Teams",
+		apiPath:  "/api/integrations/teams",
+		urlPattern: patternValidator{
+			pattern: regexp.MustCompile(`^https://\S+$`),
+			message: "Expected an https:// incoming webhook URL",
+		},
+	}
+}
+
+func NewDiscordIntegrationResource() resource.Resource {
+	return &chatWebhookIntegrationResource{
+		typeName: "signalcraft_discord_integration",
+		id:       "discord",
+		label:    "Discord",
+		apiPath:  "/api/integrations/discord",
+		urlPattern: patternValidator{
+			pattern: regexp.MustCompile(`^https://(discord|discordapp)\.com/api/webhooks/\S+$`),
+			message: "Expected a Discord webhook URL such as https://discord.com/api/webhooks/<id>/<token>",
+		},
+	}
+}
+
+func (r *chatWebhookIntegrationResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = r.typeName
+}
+
+func (r *chatWebhookIntegrationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("%s integration of the workspace. There is one per workspace.", r.label),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_url": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Incoming webhook URL. The API does not return it, so changes made outside Terraform are not detected.",
+				Validators:  []validator.String{r.urlPattern},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Integration status. Anything other than ACTIVE removes the resource from state so the next apply reconfigures it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *chatWebhookIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *chatWebhookIntegrationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan chatWebhookIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.configure(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(r.id)
+	plan.Status = types.StringValue("ACTIVE")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *chatWebhookIntegrationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state chatWebhookIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp chatWebhookIntegrationStatusResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, r.apiPath+"/status", nil, "", &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	if !apiResp.Configured || apiResp.Status == nil || *apiResp.Status != "ACTIVE" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(r.id)
+	state.Status = types.StringPointerValue(apiResp.Status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *chatWebhookIntegrationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan chatWebhookIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.configure(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	plan.Status = types.StringValue("ACTIVE")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *chatWebhookIntegrationResource) Delete(
+	ctx context.Context,
+	_ resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	err := r.client.DoJSON(ctx, http.MethodDelete, r.apiPath, nil, uuid.NewString(), nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *chatWebhookIntegrationResource) configure(ctx context.Context, plan chatWebhookIntegrationModel) error {
+	payload := chatWebhookIntegrationPayload{WebhookURL: plan.WebhookURL.ValueString()}
+	return r.client.DoJSON(ctx, http.MethodPost, r.apiPath+"/configure", payload, uuid.NewString(), nil)
+}
+
+func (r *chatWebhookIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
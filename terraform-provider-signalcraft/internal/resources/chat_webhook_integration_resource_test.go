@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestChatWebhookIntegrationURLPatterns(t *testing.T) {
+	teams := NewTeamsIntegrationResource().(*chatWebhookIntegrationResource)
+	discord := NewDiscordIntegrationResource().(*chatWebhookIntegrationResource)
+
+	requireNoDiags(t, validateString(teams.urlPattern, types.StringValue("https://example.webhook.office.com/webhookb2/abc")).Diagnostics)
+	requireErrorSummary(t, validateString(teams.urlPattern, types.StringValue("http://example.com/hook")).Diagnostics, "Invalid Value")
+
+	requireNoDiags(t, validateString(discord.urlPattern, types.StringValue("https://discord.com/api/webhooks/123/token")).Diagnostics)
+	requireErrorSummary(
+		t,
+		validateString(discord.urlPattern, types.StringValue("https://example.com/api/webhooks/123/token")).Diagnostics,
+		"Invalid Value",
+	)
+}
@@ -0,0 +1,277 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type correlationRuleResource struct {
+	client *client.Client
+}
+
+type correlationRuleModel struct {
+	ID             types.String  `tfsdk:"id"`
+	SourceGroupKey types.String  `tfsdk:"source_group_key"`
+	TargetGroupKey types.String  `tfsdk:"target_group_key"`
+	Confidence     types.Float64 `tfsdk:"confidence"`
+	Curated        types.Bool    `tfsdk:"curated"`
+	LastUpdatedAt  types.String  `tfsdk:"last_updated_at"`
+}
+
+type correlationRulePayload struct {
+	SourceGroupKey string  `json:"sourceGroupKey"`
+	TargetGroupKey string  `json:"targetGroupKey"`
+	Confidence     float64 `json:"confidence"`
+}
+
+type correlationRuleUpdatePayload struct {
+	Confidence float64 `json:"confidence"`
+}
+
+type correlationRuleResponse struct {
+	ID             string  `json:"id"`
+	SourceGroupKey string  `json:"sourceGroupKey"`
+	TargetGroupKey string  `json:"targetGroupKey"`
+	Confidence     float64 `json:"confidence"`
+	Curated        bool    `json:"curated"`
+	LastUpdatedAt  *string `json:"lastUpdatedAt"`
+}
+
+func NewCorrelationRuleResource() resource.Resource {
+	return &correlationRuleResource{}
+}
+
+func (r *correlationRuleResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_correlation_rule"
+}
+
+func (r *correlationRuleResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Human-curated correlation rule: alerts in the source group cause alerts in the target group. Correlation analysis never overwrites curated rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_group_key": schema.StringAttribute{
+				Required:    true,
+				Description: "Group key of the cause alert group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_group_key": schema.StringAttribute{
+				Required:    true,
+				Description: "Group key of the effect alert group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"confidence": schema.Float64Attribute{
+				Required:    true,
+				Description: "Between 0 and 1. Rules below 0.5 are kept but not used to link alerts.",
+				Validators: []validator.Float64{
+					float64RangeValidator{min: 0, max: 1},
+				},
+			},
+			"curated": schema.BoolAttribute{
+				Computed: true,
+			},
+			"last_updated_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *correlationRuleResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *correlationRuleResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var source, target types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_group_key"), &source)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("target_group_key"), &target)...)
+	if resp.Diagnostics.HasError() || source.IsUnknown() || target.IsUnknown() {
+		return
+	}
+
+	if source.ValueString() == target.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_group_key"),
+			"Self Correlation",
+			"source_group_key and target_group_key must name different alert groups.",
+		)
+	}
+}
+
+func (r *correlationRuleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan correlationRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := correlationRulePayload{
+		SourceGroupKey: plan.SourceGroupKey.ValueString(),
+		TargetGroupKey: plan.TargetGroupKey.ValueString(),
+		Confidence:     plan.Confidence.ValueFloat64(),
+	}
+
+	var apiResp correlationRuleResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/correlation-rules", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state := flattenCorrelationRule(apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *correlationRuleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state correlationRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp correlationRuleResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/correlation-rules/%s", state.ID.ValueString()),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState := flattenCorrelationRule(apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *correlationRuleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan correlationRuleModel
+	var state correlationRuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp correlationRuleResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("/api/correlation-rules/%s", state.ID.ValueString()),
+		correlationRuleUpdatePayload{Confidence: plan.Confidence.ValueFloat64()},
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState := flattenCorrelationRule(apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *correlationRuleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state correlationRuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/correlation-rules/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *correlationRuleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func flattenCorrelationRule(apiResp correlationRuleResponse) correlationRuleModel {
+	return correlationRuleModel{
+		ID:             types.StringValue(apiResp.ID),
+		SourceGroupKey: types.StringValue(apiResp.SourceGroupKey),
+		TargetGroupKey: types.StringValue(apiResp.TargetGroupKey),
+		Confidence:     types.Float64Value(apiResp.Confidence),
+		Curated:        types.BoolValue(apiResp.Curated),
+		LastUpdatedAt:  types.StringPointerValue(apiResp.LastUpdatedAt),
+	}
+}
@@ -0,0 +1,22 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCorrelationRuleValidateConfig(t *testing.T) {
+	r := &correlationRuleResource{}
+	rule := func(source, target types.String) correlationRuleModel {
+		return testModel(t, r, func(m *correlationRuleModel) {
+			m.SourceGroupKey = source
+			m.TargetGroupKey = target
+			m.Confidence = types.Float64Value(0.9)
+		})
+	}
+
+	requireNoDiags(t, validateConfig(t, r, rule(types.StringValue("db-primary-down"), types.StringValue("api-5xx"))))
+	requireErrorSummary(t, validateConfig(t, r, rule(types.StringValue("api-5xx"), types.StringValue("api-5xx"))), "Self Correlation")
+	requireNoDiags(t, validateConfig(t, r, rule(types.StringValue("api-5xx"), types.StringUnknown())))
+}
@@ -0,0 +1,665 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var dashboardWidgetTypes = []string{"alert_count", "alerts_by_severity", "recent_alerts", "alert_timeline"}
+
+var alertStatuses = []string{"OPEN", "ACK", "SNOOZED", "RESOLVED"}
+
+const dashboardDefaultColumns = 12
+
+type dashboardResource struct {
+	client *client.Client
+}
+
+type dashboardModel struct {
+	ID          types.String           `tfsdk:"id"`
+	TemplateID  types.String           `tfsdk:"template_id"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	IsDefault   types.Bool             `tfsdk:"is_default"`
+	Layout      *dashboardLayoutModel  `tfsdk:"layout"`
+	Widgets     []dashboardWidgetModel `tfsdk:"widget"`
+}
+
+type dashboardLayoutModel struct {
+	Columns types.Int64  `tfsdk:"columns"`
+	Rows    types.String `tfsdk:"rows"`
+}
+
+type dashboardWidgetModel struct {
+	ID        types.String `tfsdk:"id"`
+	Type      types.String `tfsdk:"type"`
+	Title     types.String `tfsdk:"title"`
+	X         types.Int64  `tfsdk:"x"`
+	Y         types.Int64  `tfsdk:"y"`
+	W         types.Int64  `tfsdk:"w"`
+	H         types.Int64  `tfsdk:"h"`
+	Status    types.String `tfsdk:"status"`
+	Severity  types.String `tfsdk:"severity"`
+	HoursBack types.Int64  `tfsdk:"hours_back"`
+	Limit     types.Int64  `tfsdk:"limit"`
+}
+
+type dashboardPayload struct {
+	Name        string      `json:"name"`
+	Description *string     `json:"description"`
+	IsDefault   bool        `json:"isDefault"`
+	Layout      interface{} `json:"layout"`
+	Widgets     interface{} `json:"widgets"`
+}
+
+type dashboardLayoutPayload struct {
+	Type    string `json:"type"`
+	Columns int64  `json:"columns"`
+	Rows    string `json:"rows"`
+}
+
+type dashboardWidgetPayload struct {
+	ID       string                  `json:"id"`
+	Type     string                  `json:"type"`
+	Title    string                  `json:"title"`
+	Position dashboardWidgetPosition `json:"position"`
+	Config   dashboardWidgetConfig   `json:"config"`
+}
+
+type dashboardWidgetPosition struct {
+	X int64 `json:"x"`
+	Y int64 `json:"y"`
+	W int64 `json:"w"`
+	H int64 `json:"h"`
+}
+
+type dashboardWidgetConfig struct {
+	Status    *string `json:"status,omitempty"`
+	Severity  *string `json:"severity,omitempty"`
+	HoursBack *int64  `json:"hoursBack,omitempty"`
+	Limit     *int64  `json:"limit,omitempty"`
+}
+
+type dashboardResponse struct {
+	ID          string                   `json:"id"`
+	Name        string                   `json:"name"`
+	Description *string                  `json:"description"`
+	IsDefault   bool                     `json:"isDefault"`
+	Layout      dashboardLayoutPayload   `json:"layout"`
+	Widgets     []dashboardWidgetPayload `json:"widgets"`
+}
+
+type dashboardTemplateResponse struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Layout      json.RawMessage `json:"layout"`
+	Widgets     json.RawMessage `json:"widgets"`
+}
+
+func NewDashboardResource() resource.Resource {
+	return &dashboardResource{}
+}
+
+func (r *dashboardResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_dashboard"
+}
+
+func (r *dashboardResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Custom dashboard. With template_id, the template supplies name, description, layout and widgets unless they are set here; template-supplied values are not tracked in state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Template ID from /api/dashboards/templates, such as SRE_OVERVIEW.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Dashboard name. Required unless template_id is set.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"is_default": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Marks the workspace default dashboard. Only one dashboard per workspace can set it.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"layout": schema.SingleNestedBlock{
+				Description: "Grid layout. Defaults to 12 columns with automatic rows.",
+				Attributes: map[string]schema.Attribute{
+					"columns": schema.Int64Attribute{
+						Optional: true,
+					},
+					"rows": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			"widget": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								oneOfValidator{values: dashboardWidgetTypes},
+							},
+						},
+						"title": schema.StringAttribute{
+							Required: true,
+						},
+						"x": schema.Int64Attribute{
+							Required: true,
+						},
+						"y": schema.Int64Attribute{
+							Required: true,
+						},
+						"w": schema.Int64Attribute{
+							Required: true,
+						},
+						"h": schema.Int64Attribute{
+							Required: true,
+						},
+						"status": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								oneOfValidator{values: alertStatuses},
+							},
+							Description: "Alert status to count or list. The API defaults to OPEN.",
+						},
+						"severity": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								oneOfValidator{values: alertSeverities},
+							},
+						},
+						"hours_back": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Time window for alert_timeline widgets.",
+						},
+						"limit": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Number of alerts for recent_alerts widgets.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dashboardResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *dashboardResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var templateID types.String
+	var name types.String
+	var layout *dashboardLayoutModel
+	var widgets types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("layout"), &layout)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("widget"), &widgets)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fromTemplate := !templateID.IsNull()
+
+	if !fromTemplate && name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Missing Name", "name is required unless template_id is set.")
+	}
+
+	columns := int64(dashboardDefaultColumns)
+	if layout != nil && !layout.Columns.IsNull() {
+		if layout.Columns.IsUnknown() {
+			return
+		}
+		columns = layout.Columns.ValueInt64()
+		if columns < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("layout").AtName("columns"),
+				"Invalid Columns",
+				"columns must be at least 1.",
+			)
+			return
+		}
+	}
+
+	if widgets.IsUnknown() || len(widgets.Elements()) == 0 {
+		return
+	}
+
+	var widgetModels []dashboardWidgetModel
+	resp.Diagnostics.Append(widgets.ElementsAs(ctx, &widgetModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	widgetIDs := make(map[string]bool, len(widgetModels))
+	for index, widget := range widgetModels {
+		widgetPath := path.Root("widget").AtListIndex(index)
+
+		if !widget.ID.IsUnknown() {
+			if widgetIDs[widget.ID.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					widgetPath.AtName("id"),
+					"Duplicate Widget ID",
+					fmt.Sprintf("Widget id %q is used more than once.", widget.ID.ValueString()),
+				)
+			}
+			widgetIDs[widget.ID.ValueString()] = true
+		}
+
+		if widget.X.IsUnknown() || widget.Y.IsUnknown() || widget.W.IsUnknown() || widget.H.IsUnknown() {
+			continue
+		}
+		if widget.X.ValueInt64() < 0 || widget.Y.ValueInt64() < 0 || widget.W.ValueInt64() < 1 || widget.H.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				widgetPath,
+				"Invalid Widget Position",
+				"x and y must not be negative, and w and h must be at least 1.",
+			)
+			continue
+		}
+		if widget.X.ValueInt64()+widget.W.ValueInt64() > columns {
+			resp.Diagnostics.AddAttributeError(
+				widgetPath.AtName("w"),
+				"Widget Outside Grid",
+				fmt.Sprintf("x + w must not exceed the %d layout columns.", columns),
+			)
+		}
+	}
+}
+
+func (r *dashboardResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var isDefault types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("is_default"), &isDefault)...)
+	if resp.Diagnostics.HasError() || !isDefault.ValueBool() {
+		return
+	}
+
+	var dashboardID types.String
+	if !req.State.Raw.IsNull() {
+		var wasDefault types.Bool
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("is_default"), &wasDefault)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &dashboardID)...)
+		if resp.Diagnostics.HasError() || wasDefault.ValueBool() {
+			return
+		}
+	}
+
+	var dashboards []dashboardResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/dashboards", nil, "", &dashboards)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	for _, dashboard := range dashboards {
+		if dashboard.IsDefault && dashboard.ID != dashboardID.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("is_default"),
+				"Default Dashboard Conflict",
+				fmt.Sprintf(
+					"Dashboard %q (%s) is already the workspace default. Only one dashboard per workspace can set is_default; unset it there first.",
+					dashboard.Name,
+					dashboard.ID,
+				),
+			)
+		}
+	}
+}
+
+func (r *dashboardResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan dashboardModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := r.buildDashboardPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp dashboardResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/dashboards", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state := flattenDashboard(apiResp, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dashboardResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state dashboardModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp *dashboardResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/dashboards/%s", state.ID.ValueString()),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+	if apiResp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenDashboard(*apiResp, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *dashboardResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan dashboardModel
+	var state dashboardModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := r.buildDashboardPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp dashboardResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("/api/dashboards/%s", state.ID.ValueString()),
+		payload,
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState := flattenDashboard(apiResp, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *dashboardResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state dashboardModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/dashboards/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *dashboardResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *dashboardResource) findTemplate(
+	ctx context.Context,
+	templateID string,
+) (dashboardTemplateResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var templates []dashboardTemplateResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/dashboards/templates", nil, "", &templates)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return dashboardTemplateResponse{}, diags
+	}
+
+	ids := make([]string, 0, len(templates))
+	for _, template := range templates {
+		if template.ID == templateID {
+			return template, diags
+		}
+		ids = append(ids, template.ID)
+	}
+
+	diags.AddAttributeError(
+		path.Root("template_id"),
+		"Unknown Template",
+		fmt.Sprintf("Template %q does not exist. Available templates: %v.", templateID, ids),
+	)
+	return dashboardTemplateResponse{}, diags
+}
+
+func (r *dashboardResource) buildDashboardPayload(
+	ctx context.Context,
+	plan dashboardModel,
+) (dashboardPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := dashboardPayload{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		IsDefault:   plan.IsDefault.ValueBool(),
+		Layout:      expandDashboardLayout(plan.Layout),
+		Widgets:     []dashboardWidgetPayload{},
+	}
+
+	if !plan.TemplateID.IsNull() {
+		template, templateDiags := r.findTemplate(ctx, plan.TemplateID.ValueString())
+		diags.Append(templateDiags...)
+		if diags.HasError() {
+			return dashboardPayload{}, diags
+		}
+
+		if plan.Name.IsNull() {
+			payload.Name = template.Name
+		}
+		if plan.Description.IsNull() {
+			payload.Description = &template.Description
+		}
+		if plan.Layout == nil {
+			payload.Layout = template.Layout
+		}
+		payload.Widgets = template.Widgets
+	}
+
+	if len(plan.Widgets) > 0 {
+		widgets := make([]dashboardWidgetPayload, 0, len(plan.Widgets))
+		for _, widget := range plan.Widgets {
+			widgets = append(widgets, dashboardWidgetPayload{
+				ID:    widget.ID.ValueString(),
+				Type:  widget.Type.ValueString(),
+				Title: widget.Title.ValueString(),
+				Position: dashboardWidgetPosition{
+					X: widget.X.ValueInt64(),
+					Y: widget.Y.ValueInt64(),
+					W: widget.W.ValueInt64(),
+					H: widget.H.ValueInt64(),
+				},
+				Config: dashboardWidgetConfig{
+					Status:    widget.Status.ValueStringPointer(),
+					Severity:  widget.Severity.ValueStringPointer(),
+					HoursBack: widget.HoursBack.ValueInt64Pointer(),
+					Limit:     widget.Limit.ValueInt64Pointer(),
+				},
+			})
+		}
+		payload.Widgets = widgets
+	}
+
+	return payload, diags
+}
+
+func expandDashboardLayout(layout *dashboardLayoutModel) dashboardLayoutPayload {
+	payload := dashboardLayoutPayload{
+		Type:    "grid",
+		Columns: dashboardDefaultColumns,
+		Rows:    "auto",
+	}
+	if layout == nil {
+		return payload
+	}
+	if !layout.Columns.IsNull() {
+		payload.Columns = layout.Columns.ValueInt64()
+	}
+	if !layout.Rows.IsNull() {
+		payload.Rows = layout.Rows.ValueString()
+	}
+	return payload
+}
+
+func flattenDashboard(apiResp dashboardResponse, prior dashboardModel) dashboardModel {
+	fromTemplate := !prior.TemplateID.IsNull()
+
+	state := dashboardModel{
+		ID:          types.StringValue(apiResp.ID),
+		TemplateID:  prior.TemplateID,
+		Name:        types.StringValue(apiResp.Name),
+		Description: types.StringPointerValue(apiResp.Description),
+		IsDefault:   types.BoolValue(apiResp.IsDefault),
+		Widgets:     make([]dashboardWidgetModel, 0, len(apiResp.Widgets)),
+	}
+
+	if fromTemplate && prior.Name.IsNull() {
+		state.Name = types.StringNull()
+	}
+	if fromTemplate && prior.Description.IsNull() {
+		state.Description = types.StringNull()
+	}
+
+	if prior.Layout != nil {
+		layout := dashboardLayoutModel{
+			Columns: types.Int64Value(apiResp.Layout.Columns),
+			Rows:    types.StringValue(apiResp.Layout.Rows),
+		}
+		if prior.Layout.Columns.IsNull() && apiResp.Layout.Columns == dashboardDefaultColumns {
+			layout.Columns = types.Int64Null()
+		}
+		if prior.Layout.Rows.IsNull() && apiResp.Layout.Rows == "auto" {
+			layout.Rows = types.StringNull()
+		}
+		state.Layout = &layout
+	}
+
+	if fromTemplate && len(prior.Widgets) == 0 {
+		return state
+	}
+
+	for _, widget := range apiResp.Widgets {
+		state.Widgets = append(state.Widgets, dashboardWidgetModel{
+			ID:        types.StringValue(widget.ID),
+			Type:      types.StringValue(widget.Type),
+			Title:     types.StringValue(widget.Title),
+			X:         types.Int64Value(widget.Position.X),
+			Y:         types.Int64Value(widget.Position.Y),
+			W:         types.Int64Value(widget.Position.W),
+			H:         types.Int64Value(widget.Position.H),
+			Status:    types.StringPointerValue(widget.Config.Status),
+			Severity:  types.StringPointerValue(widget.Config.Severity),
+			HoursBack: types.Int64PointerValue(widget.Config.HoursBack),
+			Limit:     types.Int64PointerValue(widget.Config.Limit),
+		})
+	}
+
+	return state
+}
@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testDashboardClient(t *testing.T, dashboards []dashboardResponse) *dashboardResource {
+	t.Helper()
+	return &dashboardResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/dashboards" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewEncoder(w).Encode(dashboards)
+	})}
+}
+
+func TestDashboardModifyPlan(t *testing.T) {
+	r := testDashboardClient(t, []dashboardResponse{{ID: "dash-1", Name: "Ops", IsDefault: true}})
+	dashboard := func(id types.String, isDefault bool) dashboardModel {
+		return testModel(t, r, func(m *dashboardModel) {
+			m.ID = id
+			m.Name = types.StringValue("SRE Overview")
+			m.IsDefault = types.BoolValue(isDefault)
+		})
+	}
+	dash1 := types.StringValue("dash-1")
+	dash2 := types.StringValue("dash-2")
+
+	requireErrorSummary(t, modifyPlan(t, r, nil, dashboard(types.StringUnknown(), true)).Diagnostics, "Default Dashboard Conflict")
+	requireErrorSummary(t, modifyPlan(t, r, dashboard(dash2, false), dashboard(dash2, true)).Diagnostics, "Default Dashboard Conflict")
+	requireNoDiags(t, modifyPlan(t, r, dashboard(dash1, true), dashboard(dash1, true)).Diagnostics)
+	requireNoDiags(t, modifyPlan(t, r, dashboard(dash2, false), dashboard(dash2, false)).Diagnostics)
+
+	r = testDashboardClient(t, []dashboardResponse{{ID: "dash-1", Name: "Ops"}})
+	requireNoDiags(t, modifyPlan(t, r, dashboard(dash2, false), dashboard(dash2, true)).Diagnostics)
+}
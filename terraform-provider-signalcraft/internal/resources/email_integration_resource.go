@@ -0,0 +1,314 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var (
+	sendGridAPIKeyPattern = regexp.MustCompile(`^SG\.\S+$`)
+	emailAddressPattern   = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+)
+
+const emailVerificationPollInterval = 10 * time.Second
+
+type emailIntegrationResource struct {
+	client *client.Client
+}
+
+type emailIntegrationModel struct {
+	ID                  types.String `tfsdk:"id"`
+	APIKey              types.String `tfsdk:"api_key"`
+	APIKeyVersion       types.Int64  `tfsdk:"api_key_version"`
+	FromEmail           types.String `tfsdk:"from_email"`
+	FromName            types.String `tfsdk:"from_name"`
+	WaitForVerification types.String `tfsdk:"wait_for_verification"`
+	Verified            types.Bool   `tfsdk:"verified"`
+}
+
+type emailIntegrationPayload struct {
+	APIKey    string  `json:"apiKey"`
+	FromEmail string  `json:"fromEmail"`
+	FromName  *string `json:"fromName"`
+}
+
+type emailIntegrationResponse struct {
+	Configured bool    `json:"configured"`
+	FromEmail  string  `json:"fromEmail"`
+	FromName   *string `json:"fromName"`
+	Verified   bool    `json:"verified"`
+}
+
+func NewEmailIntegrationResource() resource.Resource {
+	return &emailIntegrationResource{}
+}
+
+func (r *emailIntegrationResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_email_integration"
+}
+
+func (r *emailIntegrationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "SendGrid sender used for alert emails. There is one per workspace, and emails are only sent once the sender is verified in SendGrid.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "SendGrid API key. Write-only: it is never stored in state, so change api_key_version to send a new key.",
+				Validators: []validator.String{
+					patternValidator{pattern: sendGridAPIKeyPattern, message: "Expected a SendGrid API key starting with SG."},
+				},
+			},
+			"api_key_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change to send api_key again, for example after rotating it.",
+			},
+			"from_email": schema.StringAttribute{
+				Required:    true,
+				Description: "Sender address. It must be a verified single sender or belong to an authenticated domain in SendGrid.",
+				Validators: []validator.String{
+					patternValidator{pattern: emailAddressPattern, message: "Expected an email address"},
+				},
+			},
+			"from_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Sender name. Defaults to SignalCraft Alerts.",
+			},
+			"wait_for_verification": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long create and update wait for SendGrid to verify the sender, such as 10m. A sender still unverified then is saved with a warning. Unset, the apply does not wait.",
+				Validators:  []validator.String{durationValidator{}},
+			},
+			"verified": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *emailIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *emailIntegrationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan emailIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &plan.APIKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.putEmailIntegration(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForVerification(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *emailIntegrationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state emailIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, diags := r.getEmailIntegration(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !apiResp.Configured {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenEmailIntegration(apiResp, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *emailIntegrationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan emailIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &plan.APIKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.putEmailIntegration(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForVerification(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *emailIntegrationResource) Delete(
+	ctx context.Context,
+	_ resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	err := r.client.DoJSON(ctx, http.MethodDelete, "/settings/email", nil, uuid.NewString(), nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *emailIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *emailIntegrationResource) getEmailIntegration(ctx context.Context) (emailIntegrationResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp emailIntegrationResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/settings/email", nil, "", &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+	}
+	return apiResp, diags
+}
+
+func (r *emailIntegrationResource) putEmailIntegration(
+	ctx context.Context,
+	plan emailIntegrationModel,
+) (emailIntegrationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := emailIntegrationPayload{
+		APIKey:    plan.APIKey.ValueString(),
+		FromEmail: plan.FromEmail.ValueString(),
+		FromName:  plan.FromName.ValueStringPointer(),
+	}
+
+	var apiResp emailIntegrationResponse
+	err := r.client.DoJSON(ctx, http.MethodPut, "/settings/email", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return emailIntegrationModel{}, diags
+	}
+
+	return flattenEmailIntegration(apiResp, plan), diags
+}
+
+func (r *emailIntegrationResource) waitForVerification(
+	ctx context.Context,
+	state *emailIntegrationModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state.WaitForVerification.IsNull() || state.Verified.ValueBool() {
+		return diags
+	}
+
+	timeout, err := time.ParseDuration(state.WaitForVerification.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("wait_for_verification"), "Invalid Duration", err.Error())
+		return diags
+	}
+
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(emailVerificationPollInterval)
+	defer ticker.Stop()
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			diags.AddWarning("Sender Verification Cancelled", ctx.Err().Error())
+			return diags
+		case <-ticker.C:
+		}
+
+		apiResp, getDiags := r.getEmailIntegration(ctx)
+		if getDiags.HasError() {
+			for _, d := range getDiags.Errors() {
+				diags.AddWarning("Sender Verification Failed", d.Detail())
+			}
+			return diags
+		}
+		if apiResp.Verified {
+			state.Verified = types.BoolValue(true)
+			return diags
+		}
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("wait_for_verification"),
+		"Sender Not Verified",
+		fmt.Sprintf(
+			"SendGrid did not verify %s within %s. Verify it as a single sender or authenticate its domain in SendGrid, then refresh.",
+			state.FromEmail.ValueString(),
+			timeout,
+		),
+	)
+	return diags
+}
+
+func flattenEmailIntegration(apiResp emailIntegrationResponse, prior emailIntegrationModel) emailIntegrationModel {
+	return emailIntegrationModel{
+		ID:                  types.StringValue("email"),
+		APIKey:              types.StringNull(),
+		APIKeyVersion:       prior.APIKeyVersion,
+		FromEmail:           types.StringValue(apiResp.FromEmail),
+		FromName:            types.StringPointerValue(apiResp.FromName),
+		WaitForVerification: prior.WaitForVerification,
+		Verified:            types.BoolValue(apiResp.Verified),
+	}
+}
@@ -0,0 +1,67 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEmailIntegrationAPIKeyIsWriteOnly(t *testing.T) {
+	s := testSchema(t, &emailIntegrationResource{})
+	if !s.Attributes["api_key"].(schema.StringAttribute).WriteOnly {
+		t.Fatal("api_key must be write-only")
+	}
+}
+
+func TestEmailIntegrationCreateWarnsWhenSenderUnverified(t *testing.T) {
+	var sent []string
+	r := &emailIntegrationResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/settings/email" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		var payload emailIntegrationPayload
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		sent = append(sent, payload.APIKey)
+		_ = json.NewEncoder(w).Encode(emailIntegrationResponse{
+			Configured: true,
+			FromEmail:  payload.FromEmail,
+			Verified:   false,
+		})
+	})}
+
+	plan := testModel(t, r, func(m *emailIntegrationModel) {
+		m.APIKeyVersion = types.Int64Value(1)
+		m.FromEmail = types.StringValue("alerts@example.com")
+		m.WaitForVerification = types.StringValue("0s")
+	})
+	config := plan
+	config.APIKey = types.StringValue("SG.key")
+	req := resource.CreateRequest{
+		Config: testConfig(t, r, config),
+		Plan:   testPlan(t, r, plan),
+	}
+	resp := resource.CreateResponse{State: testState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("an unverified sender must not fail the apply: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Sender Not Verified" {
+		t.Fatalf("expected a Sender Not Verified warning, got %v", resp.Diagnostics)
+	}
+	if len(sent) != 1 || sent[0] != "SG.key" {
+		t.Fatalf("expected the configured key to be sent, got %v", sent)
+	}
+
+	var state emailIntegrationModel
+	requireNoDiags(t, resp.State.Get(context.Background(), &state))
+	if state.ID.ValueString() != "email" || !state.APIKey.IsNull() || state.Verified.ValueBool() {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+}
@@ -0,0 +1,143 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+func timestampValue(prior types.String, value *string) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		priorTime, priorErr := time.Parse(time.RFC3339, prior.ValueString())
+		valueTime, valueErr := time.Parse(time.RFC3339, *value)
+		if priorErr == nil && valueErr == nil && priorTime.Equal(valueTime) {
+			return prior
+		}
+	}
+
+	return types.StringValue(*value)
+}
+
+func jsonValue(prior types.String, value interface{}) (types.String, error) {
+	if value == nil {
+		return types.StringNull(), nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorDoc interface{}
+		var valueDoc interface{}
+		if json.Unmarshal([]byte(prior.ValueString()), &priorDoc) == nil &&
+			json.Unmarshal(encoded, &valueDoc) == nil &&
+			reflect.DeepEqual(priorDoc, valueDoc) {
+			return prior, nil
+		}
+	}
+
+	return types.StringValue(string(encoded)), nil
+}
+
+func expandStringList(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if list.IsNull() || list.IsUnknown() {
+		return nil, diags
+	}
+
+	var values []string
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	return values, diags
+}
+
+func expandStringMap(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := map[string]string{}
+	if value.IsNull() || value.IsUnknown() {
+		return values, diags
+	}
+
+	diags.Append(value.ElementsAs(ctx, &values, false)...)
+	return values, diags
+}
+
+func stringMapValue(ctx context.Context, prior types.Map, values map[string]string) (types.Map, diag.Diagnostics) {
+	if len(values) == 0 {
+		if prior.IsNull() {
+			return types.MapNull(types.StringType), nil
+		}
+		values = map[string]string{}
+	}
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
+
+func stringSetValue(ctx context.Context, prior types.Set, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		if prior.IsNull() {
+			return types.SetNull(types.StringType), nil
+		}
+		values = []string{}
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+func stringListValue(ctx context.Context, prior types.List, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 {
+		if prior.IsNull() {
+			return types.ListNull(types.StringType), nil
+		}
+		values = []string{}
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+type connectionTestResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+}
+
+func testConnection(
+	ctx context.Context,
+	c *client.Client,
+	apiPath string,
+	label string,
+	payload interface{},
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var apiResp connectionTestResponse
+	err := c.DoJSON(ctx, http.MethodPost, apiPath+"/test", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return diags
+	}
+	if !apiResp.Success {
+		diags.AddError(
+			fmt.Sprintf("%s Connection Failed", label),
+			fmt.Sprintf("%s rejected the credentials: %s", label, apiResp.Error),
+		)
+	}
+	return diags
+}
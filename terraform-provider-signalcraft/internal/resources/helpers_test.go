@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimestampValue(t *testing.T) {
+	millis := "2026-01-02T00:00:00.000Z"
+
+	prior := types.StringValue("2026-01-02T00:00:00Z")
+	if got := timestampValue(prior, &millis); !got.Equal(prior) {
+		t.Fatalf("expected the prior value for the same instant, got %s", got)
+	}
+
+	later := "2026-01-02T00:00:01.000Z"
+	if got := timestampValue(prior, &later); got.ValueString() != later {
+		t.Fatalf("expected the API value for a different instant, got %s", got)
+	}
+
+	if got := timestampValue(types.StringNull(), &millis); got.ValueString() != millis {
+		t.Fatalf("expected the API value without a prior value, got %s", got)
+	}
+
+	if got := timestampValue(prior, nil); !got.IsNull() {
+		t.Fatalf("expected null, got %s", got)
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	value := map[string]interface{}{"days": []interface{}{"mon", "tue"}, "start": "09:00"}
+
+	prior := types.StringValue(`{ "start": "09:00", "days": ["mon", "tue"] }`)
+	got, err := jsonValue(prior, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(prior) {
+		t.Fatalf("expected the prior value for the same document, got %s", got)
+	}
+
+	got, err = jsonValue(types.StringValue(`{"start": "10:00"}`), value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ValueString() != `{"days":["mon","tue"],"start":"09:00"}` {
+		t.Fatalf("expected the API document, got %s", got)
+	}
+
+	got, err = jsonValue(prior, nil)
+	if err != nil || !got.IsNull() {
+		t.Fatalf("expected null, got %s (%v)", got, err)
+	}
+}
@@ -0,0 +1,391 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var (
+	jiraBaseURLPattern    = regexp.MustCompile(`^https://[^/\s]+/?$`)
+	jiraProjectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]+$`)
+)
+
+const jiraIntegrationPath = "/api/integrations/jira"
+
+type jiraIntegrationResource struct {
+	client *client.Client
+}
+
+type jiraIntegrationModel struct {
+	ID                  types.String `tfsdk:"id"`
+	BaseURL             types.String `tfsdk:"base_url"`
+	Email               types.String `tfsdk:"email"`
+	APIToken            types.String `tfsdk:"api_token"`
+	APITokenVersion     types.Int64  `tfsdk:"api_token_version"`
+	ProjectKey          types.String `tfsdk:"project_key"`
+	IssueType           types.String `tfsdk:"issue_type"`
+	AutoCreateCritical  types.Bool   `tfsdk:"auto_create_critical"`
+	WebhookToken        types.String `tfsdk:"webhook_token"`
+	WebhookTokenVersion types.Int64  `tfsdk:"webhook_token_version"`
+	Status              types.String `tfsdk:"status"`
+	OAuthConnected      types.Bool   `tfsdk:"oauth_connected"`
+}
+
+type jiraIntegrationPayload struct {
+	BaseURL            *string `json:"baseUrl,omitempty"`
+	Email              *string `json:"email,omitempty"`
+	APIToken           *string `json:"apiToken,omitempty"`
+	ProjectKey         string  `json:"projectKey"`
+	IssueType          string  `json:"issueType"`
+	AutoCreateCritical bool    `json:"autoCreateCritical"`
+	WebhookToken       string  `json:"webhookToken"`
+}
+
+type jiraTestPayload struct {
+	BaseURL  string `json:"baseUrl"`
+	Email    string `json:"email"`
+	APIToken string `json:"apiToken"`
+}
+
+type jiraIntegrationStatusResponse struct {
+	Configured             bool    `json:"configured"`
+	Status                 *string `json:"status"`
+	BaseURL                *string `json:"baseUrl"`
+	ProjectKey             *string `json:"projectKey"`
+	IssueType              *string `json:"issueType"`
+	AutoCreateCritical     bool    `json:"autoCreateCritical"`
+	WebhookTokenConfigured bool    `json:"webhookTokenConfigured"`
+	OAuthConnected         bool    `json:"oauthConnected"`
+}
+
+func NewJiraIntegrationResource() resource.Resource {
+	return &jiraIntegrationResource{}
+}
+
+func (r *jiraIntegrationResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_jira_integration"
+}
+
+func (r *jiraIntegrationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Jira integration of the workspace. Authenticates with an API token, or with an OAuth connection made in the web app when no token is set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Jira site URL, such as https://example.atlassian.net. Required with api_token.",
+				Validators: []validator.String{
+					patternValidator{pattern: jiraBaseURLPattern, message: "Expected an https:// Jira site URL without a path"},
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Description: "Account the API token belongs to. Required with api_token.",
+			},
+			"api_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Write-only: it is never stored in state, so change api_token_version to send a new token.",
+			},
+			"api_token_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change to send api_token again, for example after rotating it.",
+			},
+			"project_key": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					patternValidator{pattern: jiraProjectKeyPattern, message: "Expected an uppercase Jira project key such as OPS"},
+				},
+			},
+			"issue_type": schema.StringAttribute{
+				Required:    true,
+				Description: "Issue type name used for created issues, such as Task or Bug.",
+			},
+			"auto_create_critical": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Create an issue for every new CRITICAL alert.",
+			},
+			"webhook_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Token Jira webhooks must present to sync issue status back. Removing it disables the webhook. Write-only: change webhook_token_version to send a new token.",
+			},
+			"webhook_token_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change to send webhook_token again. Cleared when the API reports no webhook token, so the next apply sends it.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Integration status. Anything other than ACTIVE removes the resource from state so the next apply reconfigures it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oauth_connected": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *jiraIntegrationResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var apiToken types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_token"), &apiToken)...)
+	if resp.Diagnostics.HasError() || apiToken.IsNull() {
+		return
+	}
+
+	for _, name := range []string{"base_url", "email"} {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &value)...)
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Jira Credentials",
+				name+" is required when api_token is set.",
+			)
+		}
+	}
+}
+
+func (r *jiraIntegrationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan jiraIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_token"), &plan.APIToken)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook_token"), &plan.WebhookToken)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.APIToken.IsNull() {
+		apiResp, diags := r.getStatus(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !apiResp.OAuthConnected {
+			resp.Diagnostics.AddError(
+				"Jira Not Connected",
+				"Set base_url, email and api_token, or connect Jira through OAuth in the SignalCraft web app first.",
+			)
+			return
+		}
+	}
+
+	state, diags := r.configure(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIntegrationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state jiraIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, diags := r.getStatus(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !apiResp.Configured || apiResp.Status == nil || *apiResp.Status != "ACTIVE" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenJiraIntegration(apiResp, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *jiraIntegrationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan jiraIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_token"), &plan.APIToken)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook_token"), &plan.WebhookToken)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.configure(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *jiraIntegrationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state jiraIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.APIToken.IsNull() {
+		return
+	}
+
+	err := r.client.DoJSON(ctx, http.MethodDelete, jiraIntegrationPath, nil, uuid.NewString(), nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *jiraIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraIntegrationResource) getStatus(ctx context.Context) (jiraIntegrationStatusResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp jiraIntegrationStatusResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, jiraIntegrationPath+"/status", nil, "", &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+	}
+	return apiResp, diags
+}
+
+func (r *jiraIntegrationResource) configure(
+	ctx context.Context,
+	plan jiraIntegrationModel,
+) (jiraIntegrationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !plan.APIToken.IsNull() {
+		diags.Append(testConnection(ctx, r.client, jiraIntegrationPath, "Jira", jiraTestPayload{
+			BaseURL:  plan.BaseURL.ValueString(),
+			Email:    plan.Email.ValueString(),
+			APIToken: plan.APIToken.ValueString(),
+		})...)
+		if diags.HasError() {
+			return jiraIntegrationModel{}, diags
+		}
+	}
+
+	payload := jiraIntegrationPayload{
+		BaseURL:            plan.BaseURL.ValueStringPointer(),
+		Email:              plan.Email.ValueStringPointer(),
+		APIToken:           plan.APIToken.ValueStringPointer(),
+		ProjectKey:         plan.ProjectKey.ValueString(),
+		IssueType:          plan.IssueType.ValueString(),
+		AutoCreateCritical: plan.AutoCreateCritical.ValueBool(),
+		WebhookToken:       plan.WebhookToken.ValueString(),
+	}
+	err := r.client.DoJSON(ctx, http.MethodPost, jiraIntegrationPath+"/configure", payload, uuid.NewString(), nil)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return jiraIntegrationModel{}, diags
+	}
+
+	apiResp, statusDiags := r.getStatus(ctx)
+	diags.Append(statusDiags...)
+	if diags.HasError() {
+		return jiraIntegrationModel{}, diags
+	}
+
+	return flattenJiraIntegration(apiResp, plan), diags
+}
+
+func flattenJiraIntegration(
+	apiResp jiraIntegrationStatusResponse,
+	prior jiraIntegrationModel,
+) jiraIntegrationModel {
+	baseURL := prior.BaseURL
+	if !prior.BaseURL.IsNull() {
+		baseURL = types.StringPointerValue(apiResp.BaseURL)
+	}
+
+	webhookTokenVersion := prior.WebhookTokenVersion
+	if !apiResp.WebhookTokenConfigured {
+		webhookTokenVersion = types.Int64Null()
+	}
+
+	return jiraIntegrationModel{
+		ID:                  types.StringValue("jira"),
+		BaseURL:             baseURL,
+		Email:               prior.Email,
+		APIToken:            types.StringNull(),
+		APITokenVersion:     prior.APITokenVersion,
+		ProjectKey:          types.StringPointerValue(apiResp.ProjectKey),
+		IssueType:           types.StringPointerValue(apiResp.IssueType),
+		AutoCreateCritical:  types.BoolValue(apiResp.AutoCreateCritical),
+		WebhookToken:        types.StringNull(),
+		WebhookTokenVersion: webhookTokenVersion,
+		Status:              types.StringPointerValue(apiResp.Status),
+		OAuthConnected:      types.BoolValue(apiResp.OAuthConnected),
+	}
+}
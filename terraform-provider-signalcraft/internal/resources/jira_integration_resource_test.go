@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestJiraIntegrationCredentialsAreWriteOnly(t *testing.T) {
+	s := testSchema(t, &jiraIntegrationResource{})
+	for _, name := range []string{"api_token", "webhook_token"} {
+		if !s.Attributes[name].(schema.StringAttribute).WriteOnly {
+			t.Fatalf("%s must be write-only", name)
+		}
+	}
+}
+
+func TestFlattenJiraIntegrationClearsRemovedWebhookToken(t *testing.T) {
+	status, projectKey := "ACTIVE", "OPS"
+	prior := jiraIntegrationModel{
+		BaseURL:             types.StringNull(),
+		Email:               types.StringNull(),
+		APITokenVersion:     types.Int64Value(1),
+		WebhookTokenVersion: types.Int64Value(2),
+	}
+
+	state := flattenJiraIntegration(jiraIntegrationStatusResponse{
+		Status:                 &status,
+		ProjectKey:             &projectKey,
+		WebhookTokenConfigured: true,
+	}, prior)
+	if state.WebhookTokenVersion.ValueInt64() != 2 || !state.WebhookToken.IsNull() || !state.APIToken.IsNull() {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+
+	state = flattenJiraIntegration(jiraIntegrationStatusResponse{Status: &status, ProjectKey: &projectKey}, prior)
+	if !state.WebhookTokenVersion.IsNull() {
+		t.Fatalf("expected webhook_token_version to be cleared, got %v", state.WebhookTokenVersion)
+	}
+}
+
+func TestJiraIntegrationValidateConfigRequiresCredentials(t *testing.T) {
+	r := &jiraIntegrationResource{}
+	model := jiraIntegrationModel{
+		ID:                  types.StringUnknown(),
+		BaseURL:             types.StringNull(),
+		Email:               types.StringNull(),
+		APIToken:            types.StringValue("token"),
+		APITokenVersion:     types.Int64Null(),
+		ProjectKey:          types.StringValue("OPS"),
+		IssueType:           types.StringValue("Task"),
+		AutoCreateCritical:  types.BoolValue(false),
+		WebhookToken:        types.StringNull(),
+		WebhookTokenVersion: types.Int64Null(),
+		Status:              types.StringUnknown(),
+		OAuthConnected:      types.BoolUnknown(),
+	}
+
+	requireErrorSummary(t, validateConfig(t, r, model), "Missing Jira Credentials")
+
+	model.BaseURL = types.StringValue("https://example.atlassian.net")
+	model.Email = types.StringValue("bot@example.com")
+	requireNoDiags(t, validateConfig(t, r, model))
+}
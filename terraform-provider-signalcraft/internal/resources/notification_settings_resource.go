@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var clockTimePattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+type notificationSettingsResource struct {
+	client *client.Client
+}
+
+type notificationSettingsModel struct {
+	ID                types.String `tfsdk:"id"`
+	DefaultChannel    types.String `tfsdk:"default_channel"`
+	QuietHoursEnabled types.Bool   `tfsdk:"quiet_hours_enabled"`
+	QuietHoursStart   types.String `tfsdk:"quiet_hours_start"`
+	QuietHoursEnd     types.String `tfsdk:"quiet_hours_end"`
+	EscalationEnabled types.Bool   `tfsdk:"escalation_enabled"`
+	EscalationMinutes types.Int64  `tfsdk:"escalation_minutes"`
+}
+
+type notificationSettingsPayload struct {
+	DefaultChannel    *string `json:"defaultChannel,omitempty"`
+	QuietHoursEnabled *bool   `json:"quietHoursEnabled,omitempty"`
+	QuietHoursStart   *string `json:"quietHoursStart,omitempty"`
+	QuietHoursEnd     *string `json:"quietHoursEnd,omitempty"`
+	EscalationEnabled *bool   `json:"escalationEnabled,omitempty"`
+	EscalationMinutes *int64  `json:"escalationMinutes,omitempty"`
+}
+
+type notificationSettingsResponse struct {
+	DefaultChannel    string `json:"defaultChannel"`
+	QuietHoursEnabled bool   `json:"quietHoursEnabled"`
+	QuietHoursStart   string `json:"quietHoursStart"`
+	QuietHoursEnd     string `json:"quietHoursEnd"`
+	EscalationEnabled bool   `json:"escalationEnabled"`
+	EscalationMinutes int64  `json:"escalationMinutes"`
+}
+
+func NewNotificationSettingsResource() resource.Resource {
+	return &notificationSettingsResource{}
+}
+
+func (r *notificationSettingsResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_notification_settings"
+}
+
+func (r *notificationSettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Workspace notification defaults. There is one per workspace; unset attributes keep their current value, and destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_channel": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quiet_hours_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quiet_hours_start": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					patternValidator{pattern: clockTimePattern, message: "Expected a 24-hour time such as 22:00"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"quiet_hours_end": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					patternValidator{pattern: clockTimePattern, message: "Expected a 24-hour time such as 08:00"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"escalation_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"escalation_minutes": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *notificationSettingsResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *notificationSettingsResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config notificationSettingsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.EscalationMinutes.IsNull() && !config.EscalationMinutes.IsUnknown() && config.EscalationMinutes.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("escalation_minutes"),
+			"Invalid Escalation Delay",
+			"escalation_minutes must be at least 1.",
+		)
+	}
+}
+
+func (r *notificationSettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan notificationSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp notificationSettingsResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPut,
+		"/settings/notifications",
+		buildNotificationSettingsPayload(plan),
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state := flattenNotificationSettings(apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *notificationSettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var apiResp notificationSettingsResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/settings/notifications", nil, "", &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState := flattenNotificationSettings(apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *notificationSettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan notificationSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp notificationSettingsResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPut,
+		"/settings/notifications",
+		buildNotificationSettingsPayload(plan),
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState := flattenNotificationSettings(apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *notificationSettingsResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+	return
+}
+
+func (r *notificationSettingsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func buildNotificationSettingsPayload(plan notificationSettingsModel) notificationSettingsPayload {
+	var payload notificationSettingsPayload
+	if !plan.DefaultChannel.IsUnknown() {
+		payload.DefaultChannel = plan.DefaultChannel.ValueStringPointer()
+	}
+	if !plan.QuietHoursEnabled.IsUnknown() {
+		payload.QuietHoursEnabled = plan.QuietHoursEnabled.ValueBoolPointer()
+	}
+	if !plan.QuietHoursStart.IsUnknown() {
+		payload.QuietHoursStart = plan.QuietHoursStart.ValueStringPointer()
+	}
+	if !plan.QuietHoursEnd.IsUnknown() {
+		payload.QuietHoursEnd = plan.QuietHoursEnd.ValueStringPointer()
+	}
+	if !plan.EscalationEnabled.IsUnknown() {
+		payload.EscalationEnabled = plan.EscalationEnabled.ValueBoolPointer()
+	}
+	if !plan.EscalationMinutes.IsUnknown() {
+		payload.EscalationMinutes = plan.EscalationMinutes.ValueInt64Pointer()
+	}
+	return payload
+}
+
+func flattenNotificationSettings(apiResp notificationSettingsResponse) notificationSettingsModel {
+	return notificationSettingsModel{
+		ID:                types.StringValue("notifications"),
+		DefaultChannel:    types.StringValue(apiResp.DefaultChannel),
+		QuietHoursEnabled: types.BoolValue(apiResp.QuietHoursEnabled),
+		QuietHoursStart:   types.StringValue(apiResp.QuietHoursStart),
+		QuietHoursEnd:     types.StringValue(apiResp.QuietHoursEnd),
+		EscalationEnabled: types.BoolValue(apiResp.EscalationEnabled),
+		EscalationMinutes: types.Int64Value(apiResp.EscalationMinutes),
+	}
+}
@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNotificationSettingsValidateConfig(t *testing.T) {
+	r := &notificationSettingsResource{}
+	escalatingAfter := func(minutes int64) notificationSettingsModel {
+		return testModel(t, r, func(m *notificationSettingsModel) {
+			m.DefaultChannel = types.StringValue("#ops")
+			m.EscalationMinutes = types.Int64Value(minutes)
+		})
+	}
+
+	requireNoDiags(t, validateConfig(t, r, escalatingAfter(5)))
+	requireErrorSummary(t, validateConfig(t, r, escalatingAfter(0)), "Invalid Escalation Delay")
+}
+
+func TestBuildNotificationSettingsPayloadOmitsUnsetFields(t *testing.T) {
+	model := testModel(t, &notificationSettingsResource{}, func(m *notificationSettingsModel) {
+		m.DefaultChannel = types.StringValue("#ops")
+	})
+	data, err := json.Marshal(buildNotificationSettingsPayload(model))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"defaultChannel":"#ops"}` {
+		t.Fatalf("unexpected payload: %s", data)
+	}
+}
@@ -0,0 +1,368 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type onCallOverrideResource struct {
+	client *client.Client
+}
+
+type onCallOverrideModel struct {
+	ID         types.String `tfsdk:"id"`
+	RotationID types.String `tfsdk:"rotation_id"`
+	UserID     types.String `tfsdk:"user_id"`
+	StartsAt   types.String `tfsdk:"starts_at"`
+	EndsAt     types.String `tfsdk:"ends_at"`
+	Reason     types.String `tfsdk:"reason"`
+}
+
+type onCallOverridePayload struct {
+	UserID   string  `json:"userId"`
+	StartsAt string  `json:"startsAt"`
+	EndsAt   string  `json:"endsAt"`
+	Reason   *string `json:"reason"`
+}
+
+type onCallOverrideResponse struct {
+	ID       string  `json:"id"`
+	UserID   string  `json:"userId"`
+	StartsAt string  `json:"startsAt"`
+	EndsAt   string  `json:"endsAt"`
+	Reason   *string `json:"reason"`
+}
+
+func NewOnCallOverrideResource() resource.Resource {
+	return &onCallOverrideResource{}
+}
+
+func (r *onCallOverrideResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_oncall_override"
+}
+
+func (r *onCallOverrideResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "User who is on call for the override window.",
+			},
+			"starts_at": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{rfc3339Validator{}},
+			},
+			"ends_at": schema.StringAttribute{
+				Required:    true,
+				Validators:  []validator.String{rfc3339Validator{}},
+				Description: "End of the override window. Must be after starts_at.",
+			},
+			"reason": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *onCallOverrideResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *onCallOverrideResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config onCallOverrideModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.StartsAt.IsNull() || config.StartsAt.IsUnknown() ||
+		config.EndsAt.IsNull() || config.EndsAt.IsUnknown() {
+		return
+	}
+
+	startsAt, err := time.Parse(time.RFC3339, config.StartsAt.ValueString())
+	if err != nil {
+		return
+	}
+	endsAt, err := time.Parse(time.RFC3339, config.EndsAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !endsAt.After(startsAt) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Invalid Override Window",
+			"ends_at must be after starts_at.",
+		)
+	}
+}
+
+func (r *onCallOverrideResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan onCallOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.EndsAt.IsUnknown() {
+		return
+	}
+
+	endsAt, err := time.Parse(time.RFC3339, plan.EndsAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !endsAt.After(time.Now()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Override Already Ended",
+			"The override window has already ended. Remove this resource from the configuration.",
+		)
+	}
+}
+
+func (r *onCallOverrideResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan onCallOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp onCallOverrideResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/api/oncall/rotations/%s/overrides", plan.RotationID.ValueString()),
+		buildOnCallOverridePayload(plan),
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state := flattenOnCallOverride(apiResp, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *onCallOverrideResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state onCallOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	override, found, diags := r.findOverride(ctx, state.RotationID.ValueString(), state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenOnCallOverride(override, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *onCallOverrideResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan onCallOverrideModel
+	var state onCallOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp onCallOverrideResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf(
+			"/api/oncall/rotations/%s/overrides/%s",
+			state.RotationID.ValueString(),
+			state.ID.ValueString(),
+		),
+		buildOnCallOverridePayload(plan),
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState := flattenOnCallOverride(apiResp, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *onCallOverrideResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state onCallOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if endsAt, err := time.Parse(time.RFC3339, state.EndsAt.ValueString()); err == nil && !endsAt.After(time.Now()) {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf(
+			"/api/oncall/rotations/%s/overrides/%s",
+			state.RotationID.ValueString(),
+			state.ID.ValueString(),
+		),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *onCallOverrideResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected <rotation_id>/<override_id>.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rotation_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *onCallOverrideResource) findOverride(
+	ctx context.Context,
+	rotationID string,
+	overrideID string,
+) (onCallOverrideResponse, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var overrides []onCallOverrideResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/oncall/rotations/%s/overrides", rotationID),
+		nil,
+		"",
+		&overrides,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return onCallOverrideResponse{}, false, diags
+		}
+		diags.AddError("API Error", err.Error())
+		return onCallOverrideResponse{}, false, diags
+	}
+
+	for _, override := range overrides {
+		if override.ID == overrideID {
+			return override, true, diags
+		}
+	}
+
+	return onCallOverrideResponse{}, false, diags
+}
+
+func buildOnCallOverridePayload(plan onCallOverrideModel) onCallOverridePayload {
+	return onCallOverridePayload{
+		UserID:   plan.UserID.ValueString(),
+		StartsAt: plan.StartsAt.ValueString(),
+		EndsAt:   plan.EndsAt.ValueString(),
+		Reason:   plan.Reason.ValueStringPointer(),
+	}
+}
+
+func flattenOnCallOverride(apiResp onCallOverrideResponse, prior onCallOverrideModel) onCallOverrideModel {
+	return onCallOverrideModel{
+		ID:         types.StringValue(apiResp.ID),
+		RotationID: prior.RotationID,
+		UserID:     types.StringValue(apiResp.UserID),
+		StartsAt:   timestampValue(prior.StartsAt, &apiResp.StartsAt),
+		EndsAt:     timestampValue(prior.EndsAt, &apiResp.EndsAt),
+		Reason:     types.StringPointerValue(apiResp.Reason),
+	}
+}
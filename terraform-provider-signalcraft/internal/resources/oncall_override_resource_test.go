@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOnCallOverrideValidateConfig(t *testing.T) {
+	r := &onCallOverrideResource{}
+	start := time.Now().Add(time.Hour)
+
+	requireNoDiags(t, validateConfig(t, r, testModel(t, r, func(m *onCallOverrideModel) {
+		m.StartsAt = types.StringValue(start.Format(time.RFC3339))
+		m.EndsAt = types.StringValue(start.Add(time.Hour).Format(time.RFC3339))
+	})))
+	requireErrorSummary(t, validateConfig(t, r, testModel(t, r, func(m *onCallOverrideModel) {
+		m.StartsAt = types.StringValue(start.Format(time.RFC3339))
+		m.EndsAt = m.StartsAt
+	})), "Invalid Override Window")
+}
+
+func TestOnCallOverrideModifyPlanRejectsEndedWindowOnCreate(t *testing.T) {
+	r := &onCallOverrideResource{}
+	ended := testModel(t, r, func(m *onCallOverrideModel) {
+		m.RotationID = types.StringValue("rot-1")
+		m.UserID = types.StringValue("user-1")
+		m.StartsAt = types.StringValue(time.Now().Add(-2 * time.Hour).Format(time.RFC3339))
+		m.EndsAt = types.StringValue(time.Now().Add(-time.Hour).Format(time.RFC3339))
+	})
+
+	resp := modifyPlan(t, r, nil, ended)
+	requireErrorSummary(t, resp.Diagnostics, "Override Already Ended")
+
+	ended.ID = types.StringValue("ovr-1")
+	resp = modifyPlan(t, r, ended, ended)
+	requireNoDiags(t, resp.Diagnostics)
+}
+
+func TestBuildOnCallOverridePayloadSendsNullReason(t *testing.T) {
+	model := testModel(t, &onCallOverrideResource{}, func(m *onCallOverrideModel) {
+		m.RotationID = types.StringValue("rot-1")
+		m.UserID = types.StringValue("user-1")
+		m.StartsAt = types.StringValue(time.Now().Format(time.RFC3339))
+		m.EndsAt = types.StringValue(time.Now().Add(time.Hour).Format(time.RFC3339))
+	})
+
+	data, err := json.Marshal(buildOnCallOverridePayload(model))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatal(err)
+	}
+	reason, ok := payload["reason"]
+	if !ok || reason != nil {
+		t.Fatalf("expected explicit null reason, got %s", data)
+	}
+}
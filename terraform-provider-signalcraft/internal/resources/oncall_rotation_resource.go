@@ -0,0 +1,656 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type onCallRotationResource struct {
+	client *client.Client
+}
+
+type onCallRotationModel struct {
+	ID          types.String       `tfsdk:"id"`
+	Name        types.String       `tfsdk:"name"`
+	Description types.String       `tfsdk:"description"`
+	Timezone    types.String       `tfsdk:"timezone"`
+	Layers      []onCallLayerModel `tfsdk:"layer"`
+}
+
+type onCallLayerModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	HandoffIntervalHours types.Int64  `tfsdk:"handoff_interval_hours"`
+	StartsAt             types.String `tfsdk:"starts_at"`
+	EndsAt               types.String `tfsdk:"ends_at"`
+	RestrictionsJSON     types.String `tfsdk:"restrictions_json"`
+	IsShadow             types.Bool   `tfsdk:"is_shadow"`
+	Participants         types.List   `tfsdk:"participants"`
+}
+
+type onCallLayerPayload struct {
+	Name                 *string     `json:"name,omitempty"`
+	Order                int         `json:"order"`
+	HandoffIntervalHours int64       `json:"handoffIntervalHours"`
+	StartsAt             string      `json:"startsAt"`
+	EndsAt               *string     `json:"endsAt,omitempty"`
+	RestrictionsJSON     interface{} `json:"restrictionsJson,omitempty"`
+	IsShadow             bool        `json:"isShadow"`
+}
+
+type onCallParticipantPayload struct {
+	UserID   string `json:"userId"`
+	Position int    `json:"position"`
+}
+
+type onCallRotationResponse struct {
+	ID          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description *string               `json:"description"`
+	Timezone    string                `json:"timezone"`
+	Layers      []onCallLayerResponse `json:"layers"`
+}
+
+type onCallLayerResponse struct {
+	ID                   string                      `json:"id"`
+	Name                 *string                     `json:"name"`
+	Order                int                         `json:"order"`
+	HandoffIntervalHours int64                       `json:"handoffIntervalHours"`
+	StartsAt             string                      `json:"startsAt"`
+	EndsAt               *string                     `json:"endsAt"`
+	RestrictionsJSON     interface{}                 `json:"restrictionsJson"`
+	IsShadow             bool                        `json:"isShadow"`
+	Participants         []onCallParticipantResponse `json:"participants"`
+}
+
+type onCallParticipantResponse struct {
+	ID       string `json:"id"`
+	Position int    `json:"position"`
+	User     struct {
+		ID string `json:"id"`
+	} `json:"user"`
+}
+
+func NewOnCallRotationResource() resource.Resource {
+	return &onCallRotationResource{}
+}
+
+func (r *onCallRotationResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_oncall_rotation"
+}
+
+func (r *onCallRotationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"timezone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("UTC"),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"layer": schema.ListNestedBlock{
+				Description: "Rotation layers. Block position determines the layer order.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+						"handoff_interval_hours": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(168),
+						},
+						"starts_at": schema.StringAttribute{
+							Required:   true,
+							Validators: []validator.String{rfc3339Validator{}},
+						},
+						"ends_at": schema.StringAttribute{
+							Optional:   true,
+							Validators: []validator.String{rfc3339Validator{}},
+						},
+						"restrictions_json": schema.StringAttribute{
+							Optional:    true,
+							Description: "JSON-encoded layer restrictions. Use jsonencode() in Terraform.",
+						},
+						"is_shadow": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"participants": schema.ListAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Description: "Ordered list of user IDs. The first user is on call first.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *onCallRotationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *onCallRotationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan onCallRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildSchedulePayload(plan.scheduleModel())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created scheduleResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/oncall/rotations", payload, uuid.NewString(), &created)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyLayers(ctx, created.ID, nil, plan.Layers)...)
+
+	state, diags := r.readRotationState(ctx, created.ID, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *onCallRotationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state onCallRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp onCallRotationResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/oncall/rotations/%s", state.ID.ValueString()),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState, diags := flattenOnCallRotation(ctx, apiResp, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *onCallRotationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan onCallRotationModel
+	var state onCallRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildSchedulePayload(plan.scheduleModel())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rotationID := state.ID.ValueString()
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("/api/oncall/rotations/%s", rotationID),
+		payload,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	var current onCallRotationResponse
+	err = r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/oncall/rotations/%s", rotationID),
+		nil,
+		"",
+		&current,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyLayers(ctx, rotationID, current.Layers, plan.Layers)...)
+
+	newState, diags := r.readRotationState(ctx, rotationID, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *onCallRotationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state onCallRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/oncall/rotations/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *onCallRotationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *onCallRotationResource) MoveState(ctx context.Context) []resource.StateMover {
+	var sourceSchema resource.SchemaResponse
+	(&scheduleResource{}).Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "signalcraft_schedule" {
+					return
+				}
+				if !strings.HasSuffix(req.SourceProviderAddress, "signalcraft/signalcraft") {
+					return
+				}
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move State",
+						"The signalcraft_schedule source state could not be decoded.",
+					)
+					return
+				}
+
+				var source scheduleModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				target := onCallRotationModel{
+					ID:          source.ID,
+					Name:        source.Name,
+					Description: source.Description,
+					Timezone:    source.Timezone,
+					Layers:      []onCallLayerModel{},
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+			},
+		},
+	}
+}
+
+func (r *onCallRotationResource) applyLayers(
+	ctx context.Context,
+	rotationID string,
+	current []onCallLayerResponse,
+	desired []onCallLayerModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	layersPath := fmt.Sprintf("/api/oncall/rotations/%s/layers", rotationID)
+
+	for index, layer := range desired {
+		payload, payloadDiags := buildOnCallLayerPayload(layer, index)
+		diags.Append(payloadDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		participants, listDiags := expandStringList(ctx, layer.Participants)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		var existing *onCallLayerResponse
+		if index < len(current) {
+			existing = &current[index]
+		}
+
+		if existing != nil && layerNeedsRecreate(*existing, payload) {
+			err := r.client.DoJSON(
+				ctx,
+				http.MethodDelete,
+				fmt.Sprintf("%s/%s", layersPath, existing.ID),
+				nil,
+				uuid.NewString(),
+				nil,
+			)
+			if err != nil {
+				diags.AddError("API Error", err.Error())
+				return diags
+			}
+			existing = nil
+		}
+
+		layerID := ""
+		var currentParticipants []onCallParticipantResponse
+		if existing != nil {
+			layerID = existing.ID
+			currentParticipants = existing.Participants
+			err := r.client.DoJSON(
+				ctx,
+				http.MethodPut,
+				fmt.Sprintf("%s/%s", layersPath, layerID),
+				payload,
+				uuid.NewString(),
+				nil,
+			)
+			if err != nil {
+				diags.AddError("API Error", err.Error())
+				return diags
+			}
+		} else {
+			var created onCallLayerResponse
+			err := r.client.DoJSON(ctx, http.MethodPost, layersPath, payload, uuid.NewString(), &created)
+			if err != nil {
+				diags.AddError("API Error", err.Error())
+				return diags
+			}
+			layerID = created.ID
+		}
+
+		diags.Append(r.applyParticipants(ctx, fmt.Sprintf("%s/%s", layersPath, layerID), currentParticipants, participants)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for index := len(desired); index < len(current); index++ {
+		err := r.client.DoJSON(
+			ctx,
+			http.MethodDelete,
+			fmt.Sprintf("%s/%s", layersPath, current[index].ID),
+			nil,
+			uuid.NewString(),
+			nil,
+		)
+		if err != nil {
+			if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+				continue
+			}
+			diags.AddError("API Error", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *onCallRotationResource) applyParticipants(
+	ctx context.Context,
+	layerPath string,
+	current []onCallParticipantResponse,
+	desired []string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	kept := make(map[int]bool, len(desired))
+
+	for _, participant := range current {
+		position := participant.Position
+		if position < len(desired) && !kept[position] && desired[position] == participant.User.ID {
+			kept[position] = true
+			continue
+		}
+
+		err := r.client.DoJSON(
+			ctx,
+			http.MethodDelete,
+			fmt.Sprintf("%s/participants/%s", layerPath, participant.ID),
+			nil,
+			uuid.NewString(),
+			nil,
+		)
+		if err != nil {
+			if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+				continue
+			}
+			diags.AddError("API Error", err.Error())
+			return diags
+		}
+	}
+
+	for position, userID := range desired {
+		if kept[position] {
+			continue
+		}
+		err := r.client.DoJSON(
+			ctx,
+			http.MethodPost,
+			fmt.Sprintf("%s/participants", layerPath),
+			onCallParticipantPayload{UserID: userID, Position: position},
+			uuid.NewString(),
+			nil,
+		)
+		if err != nil {
+			diags.AddError("API Error", err.Error())
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *onCallRotationResource) readRotationState(
+	ctx context.Context,
+	rotationID string,
+	prior onCallRotationModel,
+) (onCallRotationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp onCallRotationResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/oncall/rotations/%s", rotationID),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return onCallRotationModel{}, diags
+	}
+
+	return flattenOnCallRotation(ctx, apiResp, prior)
+}
+
+func (m onCallRotationModel) scheduleModel() scheduleModel {
+	return scheduleModel{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Timezone:    m.Timezone,
+	}
+}
+
+func buildOnCallLayerPayload(layer onCallLayerModel, order int) (onCallLayerPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var restrictions interface{}
+	if !layer.RestrictionsJSON.IsNull() && !layer.RestrictionsJSON.IsUnknown() {
+		if err := json.Unmarshal([]byte(layer.RestrictionsJSON.ValueString()), &restrictions); err != nil {
+			diags.AddError("Invalid restrictions_json", err.Error())
+			return onCallLayerPayload{}, diags
+		}
+	}
+
+	payload := onCallLayerPayload{
+		Name:                 layer.Name.ValueStringPointer(),
+		Order:                order,
+		HandoffIntervalHours: layer.HandoffIntervalHours.ValueInt64(),
+		StartsAt:             layer.StartsAt.ValueString(),
+		EndsAt:               layer.EndsAt.ValueStringPointer(),
+		RestrictionsJSON:     restrictions,
+		IsShadow:             layer.IsShadow.ValueBool(),
+	}
+
+	return payload, diags
+}
+
+func layerNeedsRecreate(existing onCallLayerResponse, payload onCallLayerPayload) bool {
+	return (existing.Name != nil && payload.Name == nil) ||
+		(existing.EndsAt != nil && payload.EndsAt == nil) ||
+		(existing.RestrictionsJSON != nil && payload.RestrictionsJSON == nil)
+}
+
+func flattenOnCallRotation(
+	ctx context.Context,
+	apiResp onCallRotationResponse,
+	prior onCallRotationModel,
+) (onCallRotationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	layers := make([]onCallLayerModel, 0, len(apiResp.Layers))
+	for index, layer := range apiResp.Layers {
+		var priorLayer onCallLayerModel
+		if index < len(prior.Layers) {
+			priorLayer = prior.Layers[index]
+		}
+
+		participants := make([]string, 0, len(layer.Participants))
+		for _, participant := range layer.Participants {
+			participants = append(participants, participant.User.ID)
+		}
+		participantList, listDiags := types.ListValueFrom(ctx, types.StringType, participants)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return onCallRotationModel{}, diags
+		}
+
+		restrictions, err := jsonValue(priorLayer.RestrictionsJSON, layer.RestrictionsJSON)
+		if err != nil {
+			diags.AddError("Failed to serialize restrictions", err.Error())
+			return onCallRotationModel{}, diags
+		}
+
+		layers = append(layers, onCallLayerModel{
+			ID:                   types.StringValue(layer.ID),
+			Name:                 types.StringPointerValue(layer.Name),
+			HandoffIntervalHours: types.Int64Value(layer.HandoffIntervalHours),
+			StartsAt:             timestampValue(priorLayer.StartsAt, &layer.StartsAt),
+			EndsAt:               timestampValue(priorLayer.EndsAt, layer.EndsAt),
+			RestrictionsJSON:     restrictions,
+			IsShadow:             types.BoolValue(layer.IsShadow),
+			Participants:         participantList,
+		})
+	}
+
+	state := onCallRotationModel{
+		ID:          types.StringValue(apiResp.ID),
+		Name:        types.StringValue(apiResp.Name),
+		Description: types.StringPointerValue(apiResp.Description),
+		Timezone:    types.StringValue(apiResp.Timezone),
+		Layers:      layers,
+	}
+
+	return state, diags
+}
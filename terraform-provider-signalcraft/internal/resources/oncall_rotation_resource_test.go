@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFlattenOnCallRotationKeepsParticipantOrder(t *testing.T) {
+	ctx := context.Background()
+	endsAt := "2026-02-01T00:00:00.000Z"
+	layer := onCallLayerResponse{
+		ID:                   "layer_1",
+		HandoffIntervalHours: 168,
+		StartsAt:             "2026-01-05T09:00:00.000Z",
+		EndsAt:               &endsAt,
+		RestrictionsJSON:     map[string]interface{}{"start": "09:00"},
+	}
+	for _, userID := range []string{"user_c", "user_a", "user_b"} {
+		participant := onCallParticipantResponse{Position: len(layer.Participants)}
+		participant.User.ID = userID
+		layer.Participants = append(layer.Participants, participant)
+	}
+
+	prior := onCallRotationModel{Layers: []onCallLayerModel{{
+		StartsAt:         types.StringValue("2026-01-05T09:00:00Z"),
+		EndsAt:           types.StringNull(),
+		RestrictionsJSON: types.StringValue(`{ "start": "09:00" }`),
+	}}}
+	state, diags := flattenOnCallRotation(ctx, onCallRotationResponse{
+		ID:       "rotation_1",
+		Name:     "Primary",
+		Timezone: "Europe/Berlin",
+		Layers:   []onCallLayerResponse{layer},
+	}, prior)
+	requireNoDiags(t, diags)
+
+	got := state.Layers[0]
+	var participants []string
+	requireNoDiags(t, got.Participants.ElementsAs(ctx, &participants, false))
+	if len(participants) != 3 || participants[0] != "user_c" || participants[1] != "user_a" || participants[2] != "user_b" {
+		t.Fatalf("expected participants in rotation order, got %v", participants)
+	}
+	if got.StartsAt.ValueString() != "2026-01-05T09:00:00Z" || got.EndsAt.ValueString() != endsAt {
+		t.Fatalf("unexpected timestamps: %s, %s", got.StartsAt, got.EndsAt)
+	}
+	if got.RestrictionsJSON.ValueString() != `{ "start": "09:00" }` {
+		t.Fatalf("expected the prior restrictions_json, got %s", got.RestrictionsJSON)
+	}
+}
+
+func TestLayerNeedsRecreate(t *testing.T) {
+	name := "Weekdays"
+	existing := onCallLayerResponse{Name: &name}
+
+	if !layerNeedsRecreate(existing, onCallLayerPayload{}) {
+		t.Fatal("clearing the name must recreate the layer")
+	}
+	if layerNeedsRecreate(existing, onCallLayerPayload{Name: &name}) {
+		t.Fatal("keeping the name must update the layer in place")
+	}
+	if layerNeedsRecreate(onCallLayerResponse{}, onCallLayerPayload{}) {
+		t.Fatal("a layer without optional fields must update in place")
+	}
+}
+
+func TestBuildOnCallLayerPayloadRejectsInvalidRestrictions(t *testing.T) {
+	layer := onCallLayerModel{
+		Name:                 types.StringNull(),
+		HandoffIntervalHours: types.Int64Value(24),
+		StartsAt:             types.StringValue("2026-01-05T09:00:00Z"),
+		EndsAt:               types.StringNull(),
+		RestrictionsJSON:     types.StringValue("{"),
+		IsShadow:             types.BoolValue(false),
+	}
+
+	_, diags := buildOnCallLayerPayload(layer, 0)
+	requireErrorSummary(t, diags, "Invalid restrictions_json")
+}
+
+func TestOnCallRotationMoveStateFromSchedule(t *testing.T) {
+	ctx := context.Background()
+	r := &onCallRotationResource{}
+	mover := r.MoveState(ctx)[0]
+
+	source := scheduleModel{
+		ID:          types.StringValue("schedule_1"),
+		Name:        types.StringValue("Primary"),
+		Description: types.StringNull(),
+		Timezone:    types.StringValue("UTC"),
+	}
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/signalcraft/signalcraft",
+		SourceTypeName:        "signalcraft_schedule",
+		SourceState:           &tfsdk.State{Schema: *mover.SourceSchema, Raw: testValue(t, *mover.SourceSchema, source)},
+	}
+	resp := resource.MoveStateResponse{TargetState: testState(t, r, nil)}
+	mover.StateMover(ctx, req, &resp)
+	requireNoDiags(t, resp.Diagnostics)
+
+	var state onCallRotationModel
+	requireNoDiags(t, resp.TargetState.Get(ctx, &state))
+	if state.ID.ValueString() != "schedule_1" || state.Timezone.ValueString() != "UTC" || len(state.Layers) != 0 {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+}
@@ -0,0 +1,356 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var (
+	opsgenieRegions    = []string{"us", "eu"}
+	opsgeniePriorities = []string{"P1", "P2", "P3", "P4", "P5"}
+)
+
+const opsgenieIntegrationPath = "/api/integrations/opsgenie"
+
+type opsgenieIntegrationResource struct {
+	client *client.Client
+}
+
+type opsgenieIntegrationModel struct {
+	ID               types.String              `tfsdk:"id"`
+	APIKey           types.String              `tfsdk:"api_key"`
+	APIKeyVersion    types.Int64               `tfsdk:"api_key_version"`
+	Region           types.String              `tfsdk:"region"`
+	PriorityMappings []opsgeniePriorityMapping `tfsdk:"priority_mapping"`
+	Status           types.String              `tfsdk:"status"`
+}
+
+type opsgeniePriorityMapping struct {
+	Severity types.String `tfsdk:"severity"`
+	Priority types.String `tfsdk:"priority"`
+}
+
+type opsgenieIntegrationPayload struct {
+	APIKey           string                           `json:"apiKey"`
+	Region           string                           `json:"region"`
+	PriorityMappings []opsgeniePriorityMappingPayload `json:"priorityMappings"`
+}
+
+type opsgeniePriorityMappingPayload struct {
+	Severity string `json:"severity"`
+	Priority string `json:"priority"`
+}
+
+type opsgenieTestPayload struct {
+	APIKey string `json:"apiKey"`
+	Region string `json:"region"`
+}
+
+type opsgenieIntegrationStatusResponse struct {
+	Configured       bool                             `json:"configured"`
+	Status           *string                          `json:"status"`
+	Region           *string                          `json:"region"`
+	PriorityMappings []opsgeniePriorityMappingPayload `json:"priorityMappings"`
+}
+
+func NewOpsgenieIntegrationResource() resource.Resource {
+	return &opsgenieIntegrationResource{}
+}
+
+func (r *opsgenieIntegrationResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_opsgenie_integration"
+}
+
+func (r *opsgenieIntegrationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Opsgenie integration of the workspace. Alerts open Opsgenie alerts with a priority derived from their severity.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "Opsgenie API integration key. Write-only: it is never stored in state, so change api_key_version to send a new key.",
+			},
+			"api_key_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change to send api_key again, for example after rotating it.",
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("us"),
+				Validators: []validator.String{
+					oneOfValidator{values: opsgenieRegions},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Integration status. Anything other than ACTIVE removes the resource from state so the next apply reconfigures it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"priority_mapping": schema.SetNestedBlock{
+				Description: "Opsgenie priority for one alert severity. Unmapped severities use CRITICAL=P1, HIGH=P2, MEDIUM=P3, LOW=P4 and P5 otherwise.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"severity": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								oneOfValidator{values: alertSeverities},
+							},
+						},
+						"priority": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								oneOfValidator{values: opsgeniePriorities},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *opsgenieIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *opsgenieIntegrationResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var mappings types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("priority_mapping"), &mappings)...)
+	if resp.Diagnostics.HasError() || mappings.IsUnknown() {
+		return
+	}
+
+	var mappingModels []opsgeniePriorityMapping
+	resp.Diagnostics.Append(mappings.ElementsAs(ctx, &mappingModels, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(mappingModels))
+	for _, mapping := range mappingModels {
+		if mapping.Severity.IsUnknown() {
+			continue
+		}
+		severity := mapping.Severity.ValueString()
+		if seen[severity] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("priority_mapping"),
+				"Duplicate Priority Mapping",
+				fmt.Sprintf("Severity %q has more than one priority_mapping block.", severity),
+			)
+		}
+		seen[severity] = true
+	}
+}
+
+func (r *opsgenieIntegrationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan opsgenieIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &plan.APIKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.configure(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *opsgenieIntegrationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state opsgenieIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, diags := r.getStatus(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !apiResp.Configured || apiResp.Status == nil || *apiResp.Status != "ACTIVE" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenOpsgenieIntegration(apiResp, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *opsgenieIntegrationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan opsgenieIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &plan.APIKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.configure(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *opsgenieIntegrationResource) Delete(
+	ctx context.Context,
+	_ resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	err := r.client.DoJSON(ctx, http.MethodDelete, opsgenieIntegrationPath, nil, uuid.NewString(), nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *opsgenieIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *opsgenieIntegrationResource) getStatus(
+	ctx context.Context,
+) (opsgenieIntegrationStatusResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp opsgenieIntegrationStatusResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, opsgenieIntegrationPath+"/status", nil, "", &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+	}
+	return apiResp, diags
+}
+
+func (r *opsgenieIntegrationResource) configure(
+	ctx context.Context,
+	plan opsgenieIntegrationModel,
+) (opsgenieIntegrationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	diags.Append(testConnection(ctx, r.client, opsgenieIntegrationPath, "Opsgenie", opsgenieTestPayload{
+		APIKey: plan.APIKey.ValueString(),
+		Region: plan.Region.ValueString(),
+	})...)
+	if diags.HasError() {
+		return opsgenieIntegrationModel{}, diags
+	}
+
+	payload := opsgenieIntegrationPayload{
+		APIKey:           plan.APIKey.ValueString(),
+		Region:           plan.Region.ValueString(),
+		PriorityMappings: []opsgeniePriorityMappingPayload{},
+	}
+	for _, mapping := range plan.PriorityMappings {
+		payload.PriorityMappings = append(payload.PriorityMappings, opsgeniePriorityMappingPayload{
+			Severity: mapping.Severity.ValueString(),
+			Priority: mapping.Priority.ValueString(),
+		})
+	}
+
+	err := r.client.DoJSON(ctx, http.MethodPost, opsgenieIntegrationPath+"/configure", payload, uuid.NewString(), nil)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return opsgenieIntegrationModel{}, diags
+	}
+
+	apiResp, statusDiags := r.getStatus(ctx)
+	diags.Append(statusDiags...)
+	if diags.HasError() {
+		return opsgenieIntegrationModel{}, diags
+	}
+
+	return flattenOpsgenieIntegration(apiResp, plan), diags
+}
+
+func flattenOpsgenieIntegration(
+	apiResp opsgenieIntegrationStatusResponse,
+	prior opsgenieIntegrationModel,
+) opsgenieIntegrationModel {
+	region := types.StringValue("us")
+	if apiResp.Region != nil {
+		region = types.StringValue(*apiResp.Region)
+	}
+
+	state := opsgenieIntegrationModel{
+		ID:               types.StringValue("opsgenie"),
+		APIKey:           types.StringNull(),
+		APIKeyVersion:    prior.APIKeyVersion,
+		Region:           region,
+		PriorityMappings: []opsgeniePriorityMapping{},
+		Status:           types.StringPointerValue(apiResp.Status),
+	}
+	for _, mapping := range apiResp.PriorityMappings {
+		state.PriorityMappings = append(state.PriorityMappings, opsgeniePriorityMapping{
+			Severity: types.StringValue(mapping.Severity),
+			Priority: types.StringValue(mapping.Priority),
+		})
+	}
+	return state
+}
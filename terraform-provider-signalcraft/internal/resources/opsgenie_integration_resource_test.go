@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOpsgenieIntegrationValidateConfig(t *testing.T) {
+	r := &opsgenieIntegrationResource{}
+	if !testSchema(t, r).Attributes["api_key"].(schema.StringAttribute).WriteOnly {
+		t.Fatal("api_key must be write-only")
+	}
+
+	mapping := func(severity, priority string) opsgeniePriorityMapping {
+		return opsgeniePriorityMapping{Severity: types.StringValue(severity), Priority: types.StringValue(priority)}
+	}
+	model := opsgenieIntegrationModel{
+		ID:               types.StringUnknown(),
+		APIKey:           types.StringValue("og-key"),
+		APIKeyVersion:    types.Int64Null(),
+		Region:           types.StringValue("eu"),
+		PriorityMappings: []opsgeniePriorityMapping{mapping("HIGH", "P1"), mapping("LOW", "P4")},
+		Status:           types.StringUnknown(),
+	}
+	requireNoDiags(t, validateConfig(t, r, model))
+
+	model.PriorityMappings = []opsgeniePriorityMapping{mapping("HIGH", "P1"), mapping("HIGH", "P2")}
+	requireErrorSummary(t, validateConfig(t, r, model), "Duplicate Priority Mapping")
+}
@@ -0,0 +1,358 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var pagerDutyUrgencies = []string{"high", "low"}
+
+const pagerDutyIntegrationPath = "/api/integrations/pagerduty"
+
+type pagerDutyIntegrationResource struct {
+	client *client.Client
+}
+
+type pagerDutyIntegrationModel struct {
+	ID               types.String               `tfsdk:"id"`
+	APIKey           types.String               `tfsdk:"api_key"`
+	APIKeyVersion    types.Int64                `tfsdk:"api_key_version"`
+	ServiceID        types.String               `tfsdk:"service_id"`
+	SeverityMappings []pagerDutySeverityMapping `tfsdk:"severity_mapping"`
+	Status           types.String               `tfsdk:"status"`
+}
+
+type pagerDutySeverityMapping struct {
+	Severity  types.String `tfsdk:"severity"`
+	ServiceID types.String `tfsdk:"service_id"`
+	Urgency   types.String `tfsdk:"urgency"`
+}
+
+type pagerDutyIntegrationPayload struct {
+	APIKey           string                            `json:"apiKey"`
+	ServiceID        string                            `json:"serviceId"`
+	SeverityMappings []pagerDutySeverityMappingPayload `json:"severityMappings"`
+}
+
+type pagerDutySeverityMappingPayload struct {
+	Severity  string  `json:"severity"`
+	ServiceID *string `json:"serviceId,omitempty"`
+	Urgency   *string `json:"urgency,omitempty"`
+}
+
+type pagerDutyTestPayload struct {
+	APIKey    string `json:"apiKey"`
+	ServiceID string `json:"serviceId"`
+}
+
+type pagerDutyIntegrationStatusResponse struct {
+	Configured       bool                              `json:"configured"`
+	Status           *string                           `json:"status"`
+	ServiceID        *string                           `json:"serviceId"`
+	SeverityMappings []pagerDutySeverityMappingPayload `json:"severityMappings"`
+}
+
+func NewPagerDutyIntegrationResource() resource.Resource {
+	return &pagerDutyIntegrationResource{}
+}
+
+func (r *pagerDutyIntegrationResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_pagerduty_integration"
+}
+
+func (r *pagerDutyIntegrationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "PagerDuty integration of the workspace. Alerts open PagerDuty incidents on service_id unless a severity_mapping routes them elsewhere.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "PagerDuty REST API key. Write-only: it is never stored in state, so change api_key_version to send a new key.",
+			},
+			"api_key_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Change to send api_key again, for example after rotating it.",
+			},
+			"service_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Default PagerDuty service for incidents, such as PABC123.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Integration status. Anything other than ACTIVE removes the resource from state so the next apply reconfigures it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"severity_mapping": schema.SetNestedBlock{
+				Description: "Overrides the service or urgency for one alert severity. Without a mapping, CRITICAL and HIGH alerts are high urgency and the rest low.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"severity": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								oneOfValidator{values: alertSeverities},
+							},
+						},
+						"service_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"urgency": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								oneOfValidator{values: pagerDutyUrgencies},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pagerDutyIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *pagerDutyIntegrationResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var mappings types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("severity_mapping"), &mappings)...)
+	if resp.Diagnostics.HasError() || mappings.IsUnknown() {
+		return
+	}
+
+	var mappingModels []pagerDutySeverityMapping
+	resp.Diagnostics.Append(mappings.ElementsAs(ctx, &mappingModels, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(mappingModels))
+	for _, mapping := range mappingModels {
+		if mapping.Severity.IsUnknown() {
+			continue
+		}
+		severity := mapping.Severity.ValueString()
+		if seen[severity] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("severity_mapping"),
+				"Duplicate Severity Mapping",
+				fmt.Sprintf("Severity %q has more than one severity_mapping block.", severity),
+			)
+		}
+		seen[severity] = true
+
+		if mapping.ServiceID.IsNull() && mapping.Urgency.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("severity_mapping"),
+				"Empty Severity Mapping",
+				fmt.Sprintf("Mapping for %q sets neither service_id nor urgency.", severity),
+			)
+		}
+	}
+}
+
+func (r *pagerDutyIntegrationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan pagerDutyIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &plan.APIKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.configure(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *pagerDutyIntegrationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state pagerDutyIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, diags := r.getStatus(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !apiResp.Configured || apiResp.Status == nil || *apiResp.Status != "ACTIVE" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenPagerDutyIntegration(apiResp, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *pagerDutyIntegrationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan pagerDutyIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key"), &plan.APIKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.configure(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *pagerDutyIntegrationResource) Delete(
+	ctx context.Context,
+	_ resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	err := r.client.DoJSON(ctx, http.MethodDelete, pagerDutyIntegrationPath, nil, uuid.NewString(), nil)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *pagerDutyIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *pagerDutyIntegrationResource) getStatus(
+	ctx context.Context,
+) (pagerDutyIntegrationStatusResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp pagerDutyIntegrationStatusResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, pagerDutyIntegrationPath+"/status", nil, "", &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+	}
+	return apiResp, diags
+}
+
+func (r *pagerDutyIntegrationResource) configure(
+	ctx context.Context,
+	plan pagerDutyIntegrationModel,
+) (pagerDutyIntegrationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	diags.Append(testConnection(ctx, r.client, pagerDutyIntegrationPath, "PagerDuty", pagerDutyTestPayload{
+		APIKey:    plan.APIKey.ValueString(),
+		ServiceID: plan.ServiceID.ValueString(),
+	})...)
+	if diags.HasError() {
+		return pagerDutyIntegrationModel{}, diags
+	}
+
+	payload := pagerDutyIntegrationPayload{
+		APIKey:           plan.APIKey.ValueString(),
+		ServiceID:        plan.ServiceID.ValueString(),
+		SeverityMappings: []pagerDutySeverityMappingPayload{},
+	}
+	for _, mapping := range plan.SeverityMappings {
+		payload.SeverityMappings = append(payload.SeverityMappings, pagerDutySeverityMappingPayload{
+			Severity:  mapping.Severity.ValueString(),
+			ServiceID: mapping.ServiceID.ValueStringPointer(),
+			Urgency:   mapping.Urgency.ValueStringPointer(),
+		})
+	}
+
+	err := r.client.DoJSON(ctx, http.MethodPost, pagerDutyIntegrationPath+"/configure", payload, uuid.NewString(), nil)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return pagerDutyIntegrationModel{}, diags
+	}
+
+	apiResp, statusDiags := r.getStatus(ctx)
+	diags.Append(statusDiags...)
+	if diags.HasError() {
+		return pagerDutyIntegrationModel{}, diags
+	}
+
+	return flattenPagerDutyIntegration(apiResp, plan), diags
+}
+
+func flattenPagerDutyIntegration(
+	apiResp pagerDutyIntegrationStatusResponse,
+	prior pagerDutyIntegrationModel,
+) pagerDutyIntegrationModel {
+	state := pagerDutyIntegrationModel{
+		ID:               types.StringValue("pagerduty"),
+		APIKey:           types.StringNull(),
+		APIKeyVersion:    prior.APIKeyVersion,
+		ServiceID:        types.StringPointerValue(apiResp.ServiceID),
+		SeverityMappings: []pagerDutySeverityMapping{},
+		Status:           types.StringPointerValue(apiResp.Status),
+	}
+	for _, mapping := range apiResp.SeverityMappings {
+		state.SeverityMappings = append(state.SeverityMappings, pagerDutySeverityMapping{
+			Severity:  types.StringValue(mapping.Severity),
+			ServiceID: types.StringPointerValue(mapping.ServiceID),
+			Urgency:   types.StringPointerValue(mapping.Urgency),
+		})
+	}
+	return state
+}
@@ -0,0 +1,98 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPagerDutyIntegrationCredentialsAreWriteOnly(t *testing.T) {
+	s := testSchema(t, &pagerDutyIntegrationResource{})
+	for _, name := range []string{"api_key"} {
+		if !s.Attributes[name].(schema.StringAttribute).WriteOnly {
+			t.Fatalf("%s must be write-only", name)
+		}
+	}
+}
+
+func TestPagerDutyIntegrationValidateConfig(t *testing.T) {
+	r := &pagerDutyIntegrationResource{}
+	key := types.StringValue("pd-key")
+	high := pagerDutySeverityMapping{
+		Severity:  types.StringValue("HIGH"),
+		ServiceID: types.StringNull(),
+		Urgency:   types.StringValue("high"),
+	}
+
+	withMappings := func(mappings ...pagerDutySeverityMapping) pagerDutyIntegrationModel {
+		return testModel(t, r, func(m *pagerDutyIntegrationModel) {
+			m.APIKey = key
+			m.ServiceID = types.StringValue("PABC123")
+			m.SeverityMappings = mappings
+		})
+	}
+
+	requireNoDiags(t, validateConfig(t, r, withMappings(high)))
+
+	empty := pagerDutySeverityMapping{Severity: types.StringValue("LOW"), ServiceID: types.StringNull(), Urgency: types.StringNull()}
+	requireErrorSummary(t, validateConfig(t, r, withMappings(empty)), "Empty Severity Mapping")
+
+	other := high
+	other.Urgency = types.StringValue("low")
+	requireErrorSummary(t, validateConfig(t, r, withMappings(high, other)), "Duplicate Severity Mapping")
+}
+
+func TestPagerDutyIntegrationCreateSendsKeyFromConfig(t *testing.T) {
+	var sent []string
+	r := &pagerDutyIntegrationResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case pagerDutyIntegrationPath + "/test":
+			var payload pagerDutyTestPayload
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			sent = append(sent, payload.APIKey)
+			_ = json.NewEncoder(w).Encode(connectionTestResponse{Success: true})
+		case pagerDutyIntegrationPath + "/configure":
+			var payload pagerDutyIntegrationPayload
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			sent = append(sent, payload.APIKey)
+		case pagerDutyIntegrationPath + "/status":
+			status, serviceID := "ACTIVE", "PABC123"
+			_ = json.NewEncoder(w).Encode(pagerDutyIntegrationStatusResponse{
+				Configured: true,
+				Status:     &status,
+				ServiceID:  &serviceID,
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})}
+
+	plan := testModel(t, r, func(m *pagerDutyIntegrationModel) {
+		m.APIKeyVersion = types.Int64Value(1)
+		m.ServiceID = types.StringValue("PABC123")
+	})
+	config := plan
+	config.APIKey = types.StringValue("pd-key")
+	req := resource.CreateRequest{
+		Config: testConfig(t, r, config),
+		Plan:   testPlan(t, r, plan),
+	}
+	resp := resource.CreateResponse{State: testState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	requireNoDiags(t, resp.Diagnostics)
+
+	if len(sent) != 2 || sent[0] != "pd-key" || sent[1] != "pd-key" {
+		t.Fatalf("expected the configured key to be tested and saved, got %v", sent)
+	}
+
+	var state pagerDutyIntegrationModel
+	requireNoDiags(t, resp.State.Get(context.Background(), &state))
+	if !state.APIKey.IsNull() || state.APIKeyVersion.ValueInt64() != 1 {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+}
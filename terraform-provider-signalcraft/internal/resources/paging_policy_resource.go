@@ -0,0 +1,388 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var pagingChannels = []string{"SLACK", "EMAIL", "SMS", "VOICE"}
+
+type pagingPolicyResource struct {
+	client *client.Client
+}
+
+type pagingPolicyModel struct {
+	ID          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	RotationID  types.String      `tfsdk:"rotation_id"`
+	Enabled     types.Bool        `tfsdk:"enabled"`
+	Steps       []pagingStepModel `tfsdk:"step"`
+}
+
+type pagingStepModel struct {
+	Channels              types.Set   `tfsdk:"channels"`
+	DelaySeconds          types.Int64 `tfsdk:"delay_seconds"`
+	RepeatCount           types.Int64 `tfsdk:"repeat_count"`
+	RepeatIntervalSeconds types.Int64 `tfsdk:"repeat_interval_seconds"`
+}
+
+type pagingPolicyPayload struct {
+	Name        string              `json:"name"`
+	RotationID  string              `json:"rotationId"`
+	Description *string             `json:"description"`
+	Enabled     *bool               `json:"enabled,omitempty"`
+	Steps       []pagingStepPayload `json:"steps"`
+}
+
+type pagingStepPayload struct {
+	Order                 int      `json:"order"`
+	Channels              []string `json:"channels"`
+	DelaySeconds          int64    `json:"delaySeconds"`
+	RepeatCount           int64    `json:"repeatCount"`
+	RepeatIntervalSeconds int64    `json:"repeatIntervalSeconds"`
+}
+
+type pagingPolicyResponse struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description *string             `json:"description"`
+	RotationID  string              `json:"rotationId"`
+	Enabled     bool                `json:"enabled"`
+	Steps       []pagingStepPayload `json:"steps"`
+}
+
+func NewPagingPolicyResource() resource.Resource {
+	return &pagingPolicyResource{}
+}
+
+func (r *pagingPolicyResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_paging_policy"
+}
+
+func (r *pagingPolicyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"rotation_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the signalcraft_schedule or signalcraft_oncall_rotation to page.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				Description: "Ordered paging steps. Block position determines the step order.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"channels": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								oneOfValidator{values: pagingChannels},
+							},
+							Description: "Channels to page: SLACK, EMAIL, SMS or VOICE.",
+						},
+						"delay_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(0),
+						},
+						"repeat_count": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(0),
+						},
+						"repeat_interval_seconds": schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *pagingPolicyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *pagingPolicyResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var steps types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("step"), &steps)...)
+	if resp.Diagnostics.HasError() || steps.IsUnknown() {
+		return
+	}
+
+	if len(steps.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("step"),
+			"Missing Paging Step",
+			"At least one step block is required.",
+		)
+	}
+}
+
+func (r *pagingPolicyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan pagingPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildPagingPolicyPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp pagingPolicyResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/paging/policies", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state, diags := flattenPagingPolicy(ctx, apiResp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *pagingPolicyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state pagingPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp pagingPolicyResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/paging/policies/%s", state.ID.ValueString()),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState, diags := flattenPagingPolicy(ctx, apiResp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *pagingPolicyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan pagingPolicyModel
+	var state pagingPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildPagingPolicyPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp pagingPolicyResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("/api/paging/policies/%s", state.ID.ValueString()),
+		payload,
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState, diags := flattenPagingPolicy(ctx, apiResp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *pagingPolicyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state pagingPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/paging/policies/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *pagingPolicyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func buildPagingPolicyPayload(ctx context.Context, plan pagingPolicyModel) (pagingPolicyPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	steps := make([]pagingStepPayload, 0, len(plan.Steps))
+	for index, step := range plan.Steps {
+		var channels []string
+		diags.Append(step.Channels.ElementsAs(ctx, &channels, false)...)
+		if diags.HasError() {
+			return pagingPolicyPayload{}, diags
+		}
+
+		steps = append(steps, pagingStepPayload{
+			Order:                 index,
+			Channels:              channels,
+			DelaySeconds:          step.DelaySeconds.ValueInt64(),
+			RepeatCount:           step.RepeatCount.ValueInt64(),
+			RepeatIntervalSeconds: step.RepeatIntervalSeconds.ValueInt64(),
+		})
+	}
+
+	var enabled *bool
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
+		value := plan.Enabled.ValueBool()
+		enabled = &value
+	}
+
+	payload := pagingPolicyPayload{
+		Name:        plan.Name.ValueString(),
+		RotationID:  plan.RotationID.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		Enabled:     enabled,
+		Steps:       steps,
+	}
+
+	return payload, diags
+}
+
+func flattenPagingPolicy(ctx context.Context, apiResp pagingPolicyResponse) (pagingPolicyModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	steps := make([]pagingStepModel, 0, len(apiResp.Steps))
+	for _, step := range apiResp.Steps {
+		channels, setDiags := types.SetValueFrom(ctx, types.StringType, step.Channels)
+		diags.Append(setDiags...)
+		if diags.HasError() {
+			return pagingPolicyModel{}, diags
+		}
+
+		steps = append(steps, pagingStepModel{
+			Channels:              channels,
+			DelaySeconds:          types.Int64Value(step.DelaySeconds),
+			RepeatCount:           types.Int64Value(step.RepeatCount),
+			RepeatIntervalSeconds: types.Int64Value(step.RepeatIntervalSeconds),
+		})
+	}
+
+	state := pagingPolicyModel{
+		ID:          types.StringValue(apiResp.ID),
+		Name:        types.StringValue(apiResp.Name),
+		Description: types.StringPointerValue(apiResp.Description),
+		RotationID:  types.StringValue(apiResp.RotationID),
+		Enabled:     types.BoolValue(apiResp.Enabled),
+		Steps:       steps,
+	}
+
+	return state, diags
+}
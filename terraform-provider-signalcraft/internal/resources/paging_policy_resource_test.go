@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPagingPolicyValidateConfigRequiresStep(t *testing.T) {
+	r := &pagingPolicyResource{}
+	model := pagingPolicyModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("Primary"),
+		Description: types.StringNull(),
+		RotationID:  types.StringValue("rot-1"),
+		Enabled:     types.BoolNull(),
+		Steps:       []pagingStepModel{},
+	}
+
+	requireErrorSummary(t, validateConfig(t, r, model), "Missing Paging Step")
+}
+
+func TestBuildPagingPolicyPayloadSendsNullDescription(t *testing.T) {
+	model := pagingPolicyModel{
+		Name:        types.StringValue("Primary"),
+		Description: types.StringNull(),
+		RotationID:  types.StringValue("rot-1"),
+		Enabled:     types.BoolNull(),
+	}
+
+	payload, diags := buildPagingPolicyPayload(context.Background(), model)
+	requireNoDiags(t, diags)
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	description, ok := decoded["description"]
+	if !ok || description != nil {
+		t.Fatalf("expected explicit null description, got %s", data)
+	}
+	if _, ok := decoded["enabled"]; ok {
+		t.Fatalf("expected enabled to be omitted, got %s", data)
+	}
+}
@@ -0,0 +1,301 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var commitShaPattern = regexp.MustCompile(`^[0-9a-f]{7,40}$`)
+
+type releaseResource struct {
+	client *client.Client
+}
+
+type releaseModel struct {
+	ID                types.String `tfsdk:"id"`
+	Version           types.String `tfsdk:"version"`
+	Environment       types.String `tfsdk:"environment"`
+	Project           types.String `tfsdk:"project"`
+	CommitSha         types.String `tfsdk:"commit_sha"`
+	DeployedAt        types.String `tfsdk:"deployed_at"`
+	ErrorCount        types.Int64  `tfsdk:"error_count"`
+	AffectedUsers     types.Int64  `tfsdk:"affected_users"`
+	DeltaFromPrevious types.Int64  `tfsdk:"delta_from_previous"`
+}
+
+type releasePayload struct {
+	Version     string  `json:"version"`
+	Environment string  `json:"environment"`
+	Project     string  `json:"project"`
+	CommitSha   *string `json:"commitSha,omitempty"`
+	DeployID    string  `json:"deployId"`
+	DeployedAt  *string `json:"deployedAt,omitempty"`
+}
+
+type releaseResponse struct {
+	ID          string  `json:"id"`
+	Version     string  `json:"version"`
+	Environment string  `json:"environment"`
+	Project     string  `json:"project"`
+	CommitSha   *string `json:"commitSha"`
+	DeployedAt  *string `json:"deployedAt"`
+}
+
+type releaseHealthResponse struct {
+	ErrorCount        int64 `json:"errorCount"`
+	AffectedUsers     int64 `json:"affectedUsers"`
+	DeltaFromPrevious int64 `json:"deltaFromPrevious"`
+}
+
+func NewReleaseResource() resource.Resource {
+	return &releaseResource{}
+}
+
+func (r *releaseResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_release"
+}
+
+func (r *releaseResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Deploy marker for release health. Every change records a new release; destroying the resource keeps the release history.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("default"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"commit_sha": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					patternValidator{pattern: commitShaPattern, message: "Expected a lowercase hex commit SHA of 7 to 40 characters"},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployed_at": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Deploy time. Defaults to the time the release is recorded.",
+				Validators:  []validator.String{rfc3339Validator{}},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"error_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Alert groups linked to this release, as of the last refresh.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"affected_users": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"delta_from_previous": schema.Int64Attribute{
+				Computed:    true,
+				Description: "error_count minus that of the previous release of the same project and environment.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *releaseResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *releaseResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan releaseModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := releasePayload{
+		Version:     plan.Version.ValueString(),
+		Environment: plan.Environment.ValueString(),
+		Project:     plan.Project.ValueString(),
+		CommitSha:   plan.CommitSha.ValueStringPointer(),
+		DeployID:    uuid.NewString(),
+	}
+	if !plan.DeployedAt.IsUnknown() {
+		payload.DeployedAt = plan.DeployedAt.ValueStringPointer()
+	}
+
+	var apiResp releaseResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/releases", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state := flattenRelease(apiResp, plan)
+	resp.Diagnostics.Append(r.readReleaseHealth(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *releaseResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state releaseModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp *releaseResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/releases/%s", state.ID.ValueString()),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+	if apiResp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenRelease(*apiResp, state)
+	resp.Diagnostics.Append(r.readReleaseHealth(ctx, &newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *releaseResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	resp.Diagnostics.AddError(
+		"Unsupported",
+		"Updating releases is not supported. Every change records a new release.",
+	)
+}
+
+func (r *releaseResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+	return
+}
+
+func (r *releaseResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *releaseResource) readReleaseHealth(ctx context.Context, state *releaseModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var health *releaseHealthResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/releases/%s/health", state.ID.ValueString()),
+		nil,
+		"",
+		&health,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return diags
+	}
+	if health == nil {
+		health = &releaseHealthResponse{}
+	}
+
+	state.ErrorCount = types.Int64Value(health.ErrorCount)
+	state.AffectedUsers = types.Int64Value(health.AffectedUsers)
+	state.DeltaFromPrevious = types.Int64Value(health.DeltaFromPrevious)
+	return diags
+}
+
+func flattenRelease(apiResp releaseResponse, prior releaseModel) releaseModel {
+	return releaseModel{
+		ID:                types.StringValue(apiResp.ID),
+		Version:           types.StringValue(apiResp.Version),
+		Environment:       types.StringValue(apiResp.Environment),
+		Project:           types.StringValue(apiResp.Project),
+		CommitSha:         types.StringPointerValue(apiResp.CommitSha),
+		DeployedAt:        timestampValue(prior.DeployedAt, apiResp.DeployedAt),
+		ErrorCount:        prior.ErrorCount,
+		AffectedUsers:     prior.AffectedUsers,
+		DeltaFromPrevious: prior.DeltaFromPrevious,
+	}
+}
@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRelease(t *testing.T) releaseModel {
+	return testModel(t, &releaseResource{}, func(m *releaseModel) {
+		m.Version = types.StringValue("v1.2.3")
+		m.Environment = types.StringValue("production")
+		m.Project = types.StringValue("payments-api")
+	})
+}
+
+func TestReleaseCreateRecordsRelease(t *testing.T) {
+	r := &releaseResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/releases":
+			var payload releasePayload
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Error(err)
+			}
+			if payload.DeployID == "" {
+				t.Error("expected a deploy ID")
+			}
+			deployedAt := "2030-01-01T00:00:00.000Z"
+			_ = json.NewEncoder(w).Encode(releaseResponse{
+				ID:          "rel-2",
+				Version:     payload.Version,
+				Environment: payload.Environment,
+				Project:     payload.Project,
+				DeployedAt:  &deployedAt,
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/releases/rel-2/health":
+			_ = json.NewEncoder(w).Encode(releaseHealthResponse{ErrorCount: 3})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})}
+
+	state, diags := createResource(t, r, testRelease(t))
+	requireNoDiags(t, diags)
+
+	var created releaseModel
+	requireNoDiags(t, state.Get(context.Background(), &created))
+	if created.ID.ValueString() != "rel-2" || created.ErrorCount.ValueInt64() != 3 || !created.CommitSha.IsNull() {
+		t.Fatalf("unexpected state: %+v", created)
+	}
+}
+
+func TestReleaseDeleteKeepsRelease(t *testing.T) {
+	r := &releaseResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})}
+
+	state := testRelease(t)
+	state.ID = types.StringValue("rel-1")
+	requireNoDiags(t, deleteResource(t, r, state))
+}
@@ -0,0 +1,689 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var remediationStepTypes = []string{"HTTP_REQUEST", "SCRIPT", "WEBHOOK", "SLACK_MESSAGE", "DELAY"}
+
+type remediationWorkflowResource struct {
+	client *client.Client
+}
+
+type remediationWorkflowModel struct {
+	ID          types.String              `tfsdk:"id"`
+	TemplateID  types.String              `tfsdk:"template_id"`
+	Name        types.String              `tfsdk:"name"`
+	Description types.String              `tfsdk:"description"`
+	Enabled     types.Bool                `tfsdk:"enabled"`
+	Trigger     *remediationTriggerModel  `tfsdk:"trigger"`
+	Steps       []remediationWorkflowStep `tfsdk:"step"`
+}
+
+type remediationTriggerModel struct {
+	Severity    types.Set `tfsdk:"severity"`
+	Environment types.Set `tfsdk:"environment"`
+	Project     types.Set `tfsdk:"project"`
+	Tags        types.Map `tfsdk:"tags"`
+}
+
+type remediationWorkflowStep struct {
+	ID         types.String `tfsdk:"id"`
+	Type       types.String `tfsdk:"type"`
+	Name       types.String `tfsdk:"name"`
+	ConfigJSON types.String `tfsdk:"config_json"`
+	OnSuccess  types.List   `tfsdk:"on_success"`
+	OnFailure  types.List   `tfsdk:"on_failure"`
+}
+
+type remediationWorkflowPayload struct {
+	Name        string      `json:"name"`
+	Description *string     `json:"description"`
+	Enabled     bool        `json:"enabled"`
+	Trigger     interface{} `json:"trigger"`
+	Steps       interface{} `json:"steps"`
+}
+
+type remediationTriggerPayload struct {
+	Severity    []string          `json:"severity,omitempty"`
+	Environment []string          `json:"environment,omitempty"`
+	Project     []string          `json:"project,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+type remediationStepPayload struct {
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Config    interface{} `json:"config"`
+	OnSuccess []string    `json:"onSuccess,omitempty"`
+	OnFailure []string    `json:"onFailure,omitempty"`
+}
+
+type remediationWorkflowResponse struct {
+	ID          string                    `json:"id"`
+	Name        string                    `json:"name"`
+	Description *string                   `json:"description"`
+	Enabled     bool                      `json:"enabled"`
+	Trigger     remediationTriggerPayload `json:"trigger"`
+	Steps       []remediationStepPayload  `json:"steps"`
+}
+
+type remediationTemplateResponse struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Trigger     json.RawMessage `json:"trigger"`
+	Steps       json.RawMessage `json:"steps"`
+}
+
+func NewRemediationWorkflowResource() resource.Resource {
+	return &remediationWorkflowResource{}
+}
+
+func (r *remediationWorkflowResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_remediation_workflow"
+}
+
+func (r *remediationWorkflowResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Auto-remediation workflow. With template_id, the template supplies name, description, trigger and steps unless they are set here; template-supplied values are not tracked in state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Starter template ID from /api/workflows/templates, such as RESTART_SERVICE.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Workflow name. Required unless template_id is set.",
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"trigger": schema.SingleNestedBlock{
+				Description: "Alert conditions. All set conditions must match. Required unless template_id is set.",
+				Attributes: map[string]schema.Attribute{
+					"severity": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							oneOfValidator{values: alertSeverities},
+						},
+					},
+					"environment": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"project": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"tags": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"step": schema.ListNestedBlock{
+				Description: "Workflow steps. Execution starts at the first block and follows on_success and on_failure.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required: true,
+						},
+						"type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								oneOfValidator{values: remediationStepTypes},
+							},
+						},
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"config_json": schema.StringAttribute{
+							Optional:    true,
+							Description: "Step configuration as JSON, for example url, method, headers and body for HTTP_REQUEST.",
+						},
+						"on_success": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"on_failure": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *remediationWorkflowResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *remediationWorkflowResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var templateID types.String
+	var name types.String
+	var trigger *remediationTriggerModel
+	var steps types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("template_id"), &templateID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trigger"), &trigger)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("step"), &steps)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fromTemplate := !templateID.IsNull()
+
+	if !fromTemplate && name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Missing Name", "name is required unless template_id is set.")
+	}
+
+	if trigger == nil {
+		if !fromTemplate {
+			resp.Diagnostics.AddAttributeError(path.Root("trigger"), "Missing Trigger", "A trigger block is required unless template_id is set.")
+		}
+	} else if trigger.Severity.IsNull() && trigger.Environment.IsNull() && trigger.Project.IsNull() && trigger.Tags.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("trigger"),
+			"Empty Trigger",
+			"An empty trigger matches every alert. Set at least one of severity, environment, project or tags.",
+		)
+	}
+
+	if steps.IsUnknown() {
+		return
+	}
+	if len(steps.Elements()) == 0 {
+		if !fromTemplate {
+			resp.Diagnostics.AddAttributeError(path.Root("step"), "Missing Step", "At least one step block is required unless template_id is set.")
+		}
+		return
+	}
+
+	var stepModels []remediationWorkflowStep
+	resp.Diagnostics.Append(steps.ElementsAs(ctx, &stepModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stepIDs := make(map[string]bool, len(stepModels))
+	for index, step := range stepModels {
+		if step.ID.IsUnknown() {
+			return
+		}
+		if stepIDs[step.ID.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("step").AtListIndex(index).AtName("id"),
+				"Duplicate Step ID",
+				fmt.Sprintf("Step id %q is used more than once.", step.ID.ValueString()),
+			)
+		}
+		stepIDs[step.ID.ValueString()] = true
+
+		if !step.ConfigJSON.IsNull() && !step.ConfigJSON.IsUnknown() && !json.Valid([]byte(step.ConfigJSON.ValueString())) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("step").AtListIndex(index).AtName("config_json"),
+				"Invalid JSON",
+				"config_json must be a valid JSON document.",
+			)
+		}
+	}
+
+	for index, step := range stepModels {
+		for attribute, next := range map[string]types.List{"on_success": step.OnSuccess, "on_failure": step.OnFailure} {
+			if next.IsNull() || next.IsUnknown() {
+				continue
+			}
+			for _, element := range next.Elements() {
+				target, ok := element.(types.String)
+				if !ok || target.IsNull() || target.IsUnknown() {
+					continue
+				}
+				if !stepIDs[target.ValueString()] {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("step").AtListIndex(index).AtName(attribute),
+						"Unknown Step",
+						fmt.Sprintf("No step has id %q.", target.ValueString()),
+					)
+				}
+			}
+		}
+	}
+}
+
+func (r *remediationWorkflowResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan remediationWorkflowModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := r.buildRemediationWorkflowPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp remediationWorkflowResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/workflows", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state, diags := flattenRemediationWorkflow(ctx, apiResp, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *remediationWorkflowResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state remediationWorkflowModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp *remediationWorkflowResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/workflows/%s", state.ID.ValueString()),
+		nil,
+		"",
+		&apiResp,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+	if apiResp == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState, diags := flattenRemediationWorkflow(ctx, *apiResp, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *remediationWorkflowResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan remediationWorkflowModel
+	var state remediationWorkflowModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := r.buildRemediationWorkflowPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp remediationWorkflowResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("/api/workflows/%s", state.ID.ValueString()),
+		payload,
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState, diags := flattenRemediationWorkflow(ctx, apiResp, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *remediationWorkflowResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state remediationWorkflowModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/workflows/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *remediationWorkflowResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *remediationWorkflowResource) findTemplate(
+	ctx context.Context,
+	templateID string,
+) (remediationTemplateResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var templates []remediationTemplateResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/workflows/templates", nil, "", &templates)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return remediationTemplateResponse{}, diags
+	}
+
+	ids := make([]string, 0, len(templates))
+	for _, template := range templates {
+		if template.ID == templateID {
+			return template, diags
+		}
+		ids = append(ids, template.ID)
+	}
+
+	diags.AddAttributeError(
+		path.Root("template_id"),
+		"Unknown Template",
+		fmt.Sprintf("Template %q does not exist. Available templates: %v.", templateID, ids),
+	)
+	return remediationTemplateResponse{}, diags
+}
+
+func (r *remediationWorkflowResource) buildRemediationWorkflowPayload(
+	ctx context.Context,
+	plan remediationWorkflowModel,
+) (remediationWorkflowPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := remediationWorkflowPayload{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		Enabled:     plan.Enabled.ValueBool(),
+	}
+
+	if !plan.TemplateID.IsNull() {
+		template, templateDiags := r.findTemplate(ctx, plan.TemplateID.ValueString())
+		diags.Append(templateDiags...)
+		if diags.HasError() {
+			return remediationWorkflowPayload{}, diags
+		}
+
+		if plan.Name.IsNull() {
+			payload.Name = template.Name
+		}
+		if plan.Description.IsNull() {
+			payload.Description = &template.Description
+		}
+		payload.Trigger = template.Trigger
+		payload.Steps = template.Steps
+	}
+
+	if plan.Trigger != nil {
+		trigger, triggerDiags := expandRemediationTrigger(ctx, *plan.Trigger)
+		diags.Append(triggerDiags...)
+		payload.Trigger = trigger
+	}
+
+	if len(plan.Steps) > 0 {
+		steps := make([]remediationStepPayload, 0, len(plan.Steps))
+		for _, step := range plan.Steps {
+			var config interface{} = map[string]interface{}{}
+			if !step.ConfigJSON.IsNull() {
+				if err := json.Unmarshal([]byte(step.ConfigJSON.ValueString()), &config); err != nil {
+					diags.AddError("Invalid JSON", fmt.Sprintf("config_json of step %q: %s", step.ID.ValueString(), err))
+					return remediationWorkflowPayload{}, diags
+				}
+			}
+
+			onSuccess, listDiags := expandStringList(ctx, step.OnSuccess)
+			diags.Append(listDiags...)
+			onFailure, listDiags := expandStringList(ctx, step.OnFailure)
+			diags.Append(listDiags...)
+
+			steps = append(steps, remediationStepPayload{
+				ID:        step.ID.ValueString(),
+				Type:      step.Type.ValueString(),
+				Name:      step.Name.ValueString(),
+				Config:    config,
+				OnSuccess: onSuccess,
+				OnFailure: onFailure,
+			})
+		}
+		payload.Steps = steps
+	}
+
+	return payload, diags
+}
+
+func expandRemediationTrigger(
+	ctx context.Context,
+	trigger remediationTriggerModel,
+) (remediationTriggerPayload, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	severity, setDiags := expandStringSet(trigger.Severity)
+	diags.Append(setDiags...)
+	environment, setDiags := expandStringSet(trigger.Environment)
+	diags.Append(setDiags...)
+	project, setDiags := expandStringSet(trigger.Project)
+	diags.Append(setDiags...)
+	tags, mapDiags := expandStringMap(ctx, trigger.Tags)
+	diags.Append(mapDiags...)
+
+	return remediationTriggerPayload{
+		Severity:    severity,
+		Environment: environment,
+		Project:     project,
+		Tags:        tags,
+	}, diags
+}
+
+func flattenRemediationWorkflow(
+	ctx context.Context,
+	apiResp remediationWorkflowResponse,
+	prior remediationWorkflowModel,
+) (remediationWorkflowModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	fromTemplate := !prior.TemplateID.IsNull()
+
+	state := remediationWorkflowModel{
+		ID:          types.StringValue(apiResp.ID),
+		TemplateID:  prior.TemplateID,
+		Name:        types.StringValue(apiResp.Name),
+		Description: types.StringPointerValue(apiResp.Description),
+		Enabled:     types.BoolValue(apiResp.Enabled),
+		Steps:       make([]remediationWorkflowStep, 0, len(apiResp.Steps)),
+	}
+
+	if fromTemplate && prior.Name.IsNull() {
+		state.Name = types.StringNull()
+	}
+	if fromTemplate && prior.Description.IsNull() {
+		state.Description = types.StringNull()
+	}
+
+	if !fromTemplate || prior.Trigger != nil {
+		var priorTrigger remediationTriggerModel
+		if prior.Trigger != nil {
+			priorTrigger = *prior.Trigger
+		} else {
+			priorTrigger = remediationTriggerModel{
+				Severity:    types.SetNull(types.StringType),
+				Environment: types.SetNull(types.StringType),
+				Project:     types.SetNull(types.StringType),
+				Tags:        types.MapNull(types.StringType),
+			}
+		}
+
+		trigger, triggerDiags := flattenRemediationTrigger(ctx, apiResp.Trigger, priorTrigger)
+		diags.Append(triggerDiags...)
+		if diags.HasError() {
+			return remediationWorkflowModel{}, diags
+		}
+		if prior.Trigger != nil || !trigger.isEmpty() {
+			state.Trigger = &trigger
+		}
+	}
+
+	if fromTemplate && len(prior.Steps) == 0 {
+		return state, diags
+	}
+
+	for index, step := range apiResp.Steps {
+		priorStep := remediationWorkflowStep{
+			ConfigJSON: types.StringNull(),
+			OnSuccess:  types.ListNull(types.StringType),
+			OnFailure:  types.ListNull(types.StringType),
+		}
+		if index < len(prior.Steps) {
+			priorStep = prior.Steps[index]
+		}
+
+		config := types.StringNull()
+		if configMap, ok := step.Config.(map[string]interface{}); !ok || len(configMap) > 0 || !priorStep.ConfigJSON.IsNull() {
+			value, err := jsonValue(priorStep.ConfigJSON, step.Config)
+			if err != nil {
+				diags.AddError("Invalid API Response", fmt.Sprintf("config of step %q: %s", step.ID, err))
+				return remediationWorkflowModel{}, diags
+			}
+			config = value
+		}
+
+		onSuccess, listDiags := stringListValue(ctx, priorStep.OnSuccess, step.OnSuccess)
+		diags.Append(listDiags...)
+		onFailure, listDiags := stringListValue(ctx, priorStep.OnFailure, step.OnFailure)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return remediationWorkflowModel{}, diags
+		}
+
+		state.Steps = append(state.Steps, remediationWorkflowStep{
+			ID:         types.StringValue(step.ID),
+			Type:       types.StringValue(step.Type),
+			Name:       types.StringValue(step.Name),
+			ConfigJSON: config,
+			OnSuccess:  onSuccess,
+			OnFailure:  onFailure,
+		})
+	}
+
+	return state, diags
+}
+
+func flattenRemediationTrigger(
+	ctx context.Context,
+	trigger remediationTriggerPayload,
+	prior remediationTriggerModel,
+) (remediationTriggerModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	severity, setDiags := stringSetValue(ctx, prior.Severity, trigger.Severity)
+	diags.Append(setDiags...)
+	environment, setDiags := stringSetValue(ctx, prior.Environment, trigger.Environment)
+	diags.Append(setDiags...)
+	project, setDiags := stringSetValue(ctx, prior.Project, trigger.Project)
+	diags.Append(setDiags...)
+	tags, mapDiags := stringMapValue(ctx, prior.Tags, trigger.Tags)
+	diags.Append(mapDiags...)
+
+	return remediationTriggerModel{
+		Severity:    severity,
+		Environment: environment,
+		Project:     project,
+		Tags:        tags,
+	}, diags
+}
+
+func (m remediationTriggerModel) isEmpty() bool {
+	return m.Severity.IsNull() && m.Environment.IsNull() && m.Project.IsNull() && m.Tags.IsNull()
+}
@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testRemediationStep(id, next string) remediationWorkflowStep {
+	onSuccess := types.ListNull(types.StringType)
+	if next != "" {
+		onSuccess = types.ListValueMust(types.StringType, []attr.Value{types.StringValue(next)})
+	}
+	return remediationWorkflowStep{
+		ID:         types.StringValue(id),
+		Type:       types.StringValue("HTTP_REQUEST"),
+		Name:       types.StringValue(id),
+		ConfigJSON: types.StringValue(`{"url":"https://example.com"}`),
+		OnSuccess:  onSuccess,
+		OnFailure:  types.ListNull(types.StringType),
+	}
+}
+
+func testRemediationWorkflow(t *testing.T, steps ...remediationWorkflowStep) remediationWorkflowModel {
+	return testModel(t, &remediationWorkflowResource{}, func(m *remediationWorkflowModel) {
+		m.Name = types.StringValue("restart")
+		m.Enabled = types.BoolValue(true)
+		m.Trigger = &remediationTriggerModel{
+			Severity:    types.SetValueMust(types.StringType, []attr.Value{types.StringValue("CRITICAL")}),
+			Environment: types.SetNull(types.StringType),
+			Project:     types.SetNull(types.StringType),
+			Tags:        types.MapNull(types.StringType),
+		}
+		m.Steps = steps
+	})
+}
+
+func TestRemediationWorkflowValidateConfig(t *testing.T) {
+	r := &remediationWorkflowResource{}
+
+	requireNoDiags(t, validateConfig(t, r, testRemediationWorkflow(t, testRemediationStep("a", "b"), testRemediationStep("b", ""))))
+	requireErrorSummary(t, validateConfig(t, r, testRemediationWorkflow(t)), "Missing Step")
+	requireErrorSummary(t, validateConfig(t, r, testRemediationWorkflow(t, testRemediationStep("a", "c"))), "Unknown Step")
+	requireErrorSummary(
+		t,
+		validateConfig(t, r, testRemediationWorkflow(t, testRemediationStep("a", ""), testRemediationStep("a", ""))),
+		"Duplicate Step ID",
+	)
+}
+
+func TestBuildRemediationWorkflowPayloadSendsStepsAsArray(t *testing.T) {
+	r := &remediationWorkflowResource{}
+	model := testRemediationWorkflow(t, testRemediationStep("a", "b"), testRemediationStep("b", ""))
+
+	payload, diags := r.buildRemediationWorkflowPayload(context.Background(), model)
+	requireNoDiags(t, diags)
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Steps []map[string]any `json:"steps"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("expected steps as an array, got %s", data)
+	}
+	if len(decoded.Steps) != 2 || decoded.Steps[0]["id"] != "a" {
+		t.Fatalf("unexpected steps: %s", data)
+	}
+}
@@ -0,0 +1,153 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+func testSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+	var resp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	requireNoDiags(t, resp.Diagnostics)
+	return resp.Schema
+}
+
+func testValue(t *testing.T, s schema.Schema, model any) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if model != nil {
+		requireNoDiags(t, state.Set(ctx, model))
+	}
+	return state.Raw
+}
+
+func testConfig(t *testing.T, r resource.Resource, model any) tfsdk.Config {
+	t.Helper()
+	s := testSchema(t, r)
+	return tfsdk.Config{Schema: s, Raw: testValue(t, s, model)}
+}
+
+func testPlan(t *testing.T, r resource.Resource, model any) tfsdk.Plan {
+	t.Helper()
+	s := testSchema(t, r)
+	return tfsdk.Plan{Schema: s, Raw: testValue(t, s, model)}
+}
+
+func testState(t *testing.T, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+	s := testSchema(t, r)
+	return tfsdk.State{Schema: s, Raw: testValue(t, s, model)}
+}
+
+func testModel[T any](t *testing.T, r resource.Resource, set func(*T)) T {
+	t.Helper()
+	ctx := context.Background()
+	s := testSchema(t, r)
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		var value interface{}
+		if attribute, ok := s.Attributes[name]; ok && attribute.IsComputed() {
+			value = tftypes.UnknownValue
+		}
+		values[name] = tftypes.NewValue(attrType, value)
+	}
+
+	var model T
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objectType, values)}
+	requireNoDiags(t, plan.Get(ctx, &model))
+	if set != nil {
+		set(&model)
+	}
+	return model
+}
+
+func validateConfig(t *testing.T, r resource.ResourceWithValidateConfig, model any) diag.Diagnostics {
+	t.Helper()
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(
+		context.Background(),
+		resource.ValidateConfigRequest{Config: testConfig(t, r, model)},
+		&resp,
+	)
+	return resp.Diagnostics
+}
+
+func modifyPlan(
+	t *testing.T,
+	r resource.ResourceWithModifyPlan,
+	state any,
+	plan any,
+) resource.ModifyPlanResponse {
+	t.Helper()
+	req := resource.ModifyPlanRequest{
+		Config: testConfig(t, r, plan),
+		Plan:   testPlan(t, r, plan),
+		State:  testState(t, r, state),
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, &resp)
+	return resp
+}
+
+func createResource(t *testing.T, r resource.Resource, plan any) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	req := resource.CreateRequest{
+		Config: testConfig(t, r, plan),
+		Plan:   testPlan(t, r, plan),
+	}
+	resp := resource.CreateResponse{State: testState(t, r, nil)}
+	r.Create(context.Background(), req, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func readResource(t *testing.T, r resource.Resource, state any) resource.ReadResponse {
+	t.Helper()
+	req := resource.ReadRequest{State: testState(t, r, state)}
+	resp := resource.ReadResponse{State: req.State}
+	r.Read(context.Background(), req, &resp)
+	return resp
+}
+
+func deleteResource(t *testing.T, r resource.Resource, state any) diag.Diagnostics {
+	t.Helper()
+	req := resource.DeleteRequest{State: testState(t, r, state)}
+	resp := resource.DeleteResponse{State: req.State}
+	r.Delete(context.Background(), req, &resp)
+	return resp.Diagnostics
+}
+
+func testClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return client.New(server.URL, "sk_live_test")
+}
+
+func requireNoDiags(t *testing.T, diags diag.Diagnostics) {
+	t.Helper()
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func requireErrorSummary(t *testing.T, diags diag.Diagnostics, summary string) {
+	t.Helper()
+	for _, d := range diags.Errors() {
+		if d.Summary() == summary {
+			return
+		}
+	}
+	t.Fatalf("expected error %q, got %v", summary, diags)
+}
@@ -0,0 +1,421 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var customizableRoles = []string{"ADMIN", "MEMBER"}
+
+var permissionActions = []string{"READ", "WRITE", "DELETE", "MANAGE"}
+
+type rolePermissionsResource struct {
+	client *client.Client
+}
+
+type rolePermissionsModel struct {
+	ID     types.String          `tfsdk:"id"`
+	Role   types.String          `tfsdk:"role"`
+	Grants []rolePermissionGrant `tfsdk:"grant"`
+}
+
+type rolePermissionGrant struct {
+	Resource types.String `tfsdk:"resource"`
+	Actions  types.Set    `tfsdk:"actions"`
+}
+
+type rolePermissionsPayload struct {
+	Permissions map[string][]string `json:"permissions"`
+}
+
+type rolePermissionsUpdateResponse struct {
+	Success     bool                `json:"success"`
+	Permissions map[string][]string `json:"permissions"`
+	Error       string              `json:"error"`
+}
+
+type permissionResourcesResponse struct {
+	Resources []string `json:"resources"`
+	Actions   []string `json:"actions"`
+}
+
+func NewRolePermissionsResource() resource.Resource {
+	return &rolePermissionsResource{}
+}
+
+func (r *rolePermissionsResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_role_permissions"
+}
+
+func (r *rolePermissionsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Authoritative permission grants for a workspace role. Resources without a grant get no actions. Destroying the resource restores the default grants.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					oneOfValidator{values: customizableRoles},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"grant": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource": schema.StringAttribute{
+							Required:    true,
+							Description: "Resource name from /api/permissions/resources, such as alerts or api-keys.",
+						},
+						"actions": schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+							Validators: []validator.Set{
+								oneOfValidator{values: permissionActions},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *rolePermissionsResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *rolePermissionsResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var grants types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("grant"), &grants)...)
+	if resp.Diagnostics.HasError() || grants.IsUnknown() {
+		return
+	}
+
+	var grantModels []rolePermissionGrant
+	resp.Diagnostics.Append(grants.ElementsAs(ctx, &grantModels, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]bool, len(grantModels))
+	for _, grant := range grantModels {
+		if grant.Resource.IsUnknown() {
+			continue
+		}
+		name := grant.Resource.ValueString()
+		if seen[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("grant"),
+				"Duplicate Grant",
+				fmt.Sprintf("Resource %q has more than one grant block.", name),
+			)
+		}
+		seen[name] = true
+
+		if !grant.Actions.IsUnknown() && len(grant.Actions.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("grant"),
+				"Empty Grant",
+				fmt.Sprintf("Grant for %q has no actions. Omit the grant to deny every action on it.", name),
+			)
+		}
+	}
+}
+
+func (r *rolePermissionsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var grants types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("grant"), &grants)...)
+	if resp.Diagnostics.HasError() || grants.IsUnknown() || len(grants.Elements()) == 0 {
+		return
+	}
+
+	var grantModels []rolePermissionGrant
+	resp.Diagnostics.Append(grants.ElementsAs(ctx, &grantModels, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known, diags := r.permissionResources(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, grant := range grantModels {
+		if grant.Resource.IsUnknown() || containsString(known, grant.Resource.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("grant"),
+			"Unknown Permission Resource",
+			fmt.Sprintf("Resource %q does not exist. Available resources: %v.", grant.Resource.ValueString(), known),
+		)
+	}
+}
+
+func (r *rolePermissionsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan rolePermissionsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := r.replaceRolePermissions(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := flattenRolePermissions(ctx, plan.Role.ValueString(), permissions)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *rolePermissionsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state rolePermissionsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var permissions map[string][]string
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/permissions/role/%s", state.Role.ValueString()),
+		nil,
+		"",
+		&permissions,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState, diags := flattenRolePermissions(ctx, state.Role.ValueString(), permissions)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *rolePermissionsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan rolePermissionsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := r.replaceRolePermissions(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := flattenRolePermissions(ctx, plan.Role.ValueString(), permissions)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *rolePermissionsResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state rolePermissionsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var defaults map[string]map[string][]string
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/permissions/defaults", nil, "", &defaults)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	roleDefaults, ok := defaults[state.Role.ValueString()]
+	if !ok {
+		resp.Diagnostics.AddError(
+			"API Error",
+			fmt.Sprintf("No default permissions found for role %s.", state.Role.ValueString()),
+		)
+		return
+	}
+
+	_, diags := r.putRolePermissions(ctx, state.Role.ValueString(), roleDefaults)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *rolePermissionsResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	if !containsString(customizableRoles, req.ID) {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected one of %v, got %q.", customizableRoles, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), req.ID)...)
+}
+
+func (r *rolePermissionsResource) permissionResources(ctx context.Context) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp permissionResourcesResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/permissions/resources", nil, "", &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+	}
+	return apiResp.Resources, diags
+}
+
+func (r *rolePermissionsResource) replaceRolePermissions(
+	ctx context.Context,
+	plan rolePermissionsModel,
+) (map[string][]string, diag.Diagnostics) {
+	known, diags := r.permissionResources(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	permissions := make(map[string][]string, len(known))
+	for _, name := range known {
+		permissions[name] = []string{}
+	}
+	for _, grant := range plan.Grants {
+		var actions []string
+		diags.Append(grant.Actions.ElementsAs(ctx, &actions, false)...)
+		permissions[grant.Resource.ValueString()] = actions
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	updated, putDiags := r.putRolePermissions(ctx, plan.Role.ValueString(), permissions)
+	diags.Append(putDiags...)
+	return updated, diags
+}
+
+func (r *rolePermissionsResource) putRolePermissions(
+	ctx context.Context,
+	role string,
+	permissions map[string][]string,
+) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp rolePermissionsUpdateResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPut,
+		fmt.Sprintf("/api/permissions/role/%s", role),
+		rolePermissionsPayload{Permissions: permissions},
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return nil, diags
+	}
+	if !apiResp.Success {
+		diags.AddError("API Error", fmt.Sprintf("Updating %s permissions failed: %s", role, apiResp.Error))
+		return nil, diags
+	}
+
+	return apiResp.Permissions, diags
+}
+
+func flattenRolePermissions(
+	ctx context.Context,
+	role string,
+	permissions map[string][]string,
+) (rolePermissionsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := rolePermissionsModel{
+		ID:     types.StringValue(role),
+		Role:   types.StringValue(role),
+		Grants: []rolePermissionGrant{},
+	}
+
+	names := make([]string, 0, len(permissions))
+	for name, actions := range permissions {
+		if len(actions) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		actions, setDiags := types.SetValueFrom(ctx, types.StringType, permissions[name])
+		diags.Append(setDiags...)
+		state.Grants = append(state.Grants, rolePermissionGrant{
+			Resource: types.StringValue(name),
+			Actions:  actions,
+		})
+	}
+
+	return state, diags
+}
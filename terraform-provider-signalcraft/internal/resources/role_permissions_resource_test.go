@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testGrant(resource string, actions ...string) rolePermissionGrant {
+	values := make([]attr.Value, 0, len(actions))
+	for _, action := range actions {
+		values = append(values, types.StringValue(action))
+	}
+	return rolePermissionGrant{
+		Resource: types.StringValue(resource),
+		Actions:  types.SetValueMust(types.StringType, values),
+	}
+}
+
+func testRolePermissions(t *testing.T, grants ...rolePermissionGrant) rolePermissionsModel {
+	return testModel(t, &rolePermissionsResource{}, func(m *rolePermissionsModel) {
+		m.Role = types.StringValue("MEMBER")
+		m.Grants = grants
+	})
+}
+
+func TestRolePermissionsValidateConfig(t *testing.T) {
+	r := &rolePermissionsResource{}
+
+	requireNoDiags(t, validateConfig(t, r, testRolePermissions(t, testGrant("alerts", "READ"), testGrant("settings", "READ"))))
+	requireErrorSummary(
+		t,
+		validateConfig(t, r, testRolePermissions(t, testGrant("alerts", "READ"), testGrant("alerts", "WRITE"))),
+		"Duplicate Grant",
+	)
+	requireErrorSummary(t, validateConfig(t, r, testRolePermissions(t, testGrant("alerts"))), "Empty Grant")
+}
+
+func TestRolePermissionsModifyPlanRejectsUnknownResource(t *testing.T) {
+	r := &rolePermissionsResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(permissionResourcesResponse{Resources: []string{"alerts", "settings"}})
+	})}
+
+	requireNoDiags(t, modifyPlan(t, r, nil, testRolePermissions(t, testGrant("alerts", "READ"))).Diagnostics)
+	requireErrorSummary(
+		t,
+		modifyPlan(t, r, nil, testRolePermissions(t, testGrant("alert", "READ"))).Diagnostics,
+		"Unknown Permission Resource",
+	)
+}
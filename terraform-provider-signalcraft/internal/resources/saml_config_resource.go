@@ -0,0 +1,352 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+const samlCertificateWarnWithin = 30 * 24 * time.Hour
+
+type samlConfigResource struct {
+	client *client.Client
+}
+
+type samlConfigModel struct {
+	ID              types.String `tfsdk:"id"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Enforced        types.Bool   `tfsdk:"enforced"`
+	IdpEntityID     types.String `tfsdk:"idp_entity_id"`
+	IdpSsoURL       types.String `tfsdk:"idp_sso_url"`
+	IdpCertificate  types.String `tfsdk:"idp_certificate"`
+	AllowedDomains  types.Set    `tfsdk:"allowed_domains"`
+	JitProvisioning types.Bool   `tfsdk:"jit_provisioning"`
+	SpEntityID      types.String `tfsdk:"sp_entity_id"`
+	SpMetadata      types.String `tfsdk:"sp_metadata"`
+}
+
+type samlConfigPayload struct {
+	Enabled         bool     `json:"enabled"`
+	Enforced        bool     `json:"enforced"`
+	IdpEntityID     *string  `json:"idpEntityId"`
+	IdpSsoURL       *string  `json:"idpSsoUrl"`
+	IdpCertificate  *string  `json:"idpCertificate"`
+	AllowedDomains  []string `json:"allowedDomains"`
+	JitProvisioning bool     `json:"jitProvisioning"`
+}
+
+type samlConfigDisablePayload struct {
+	Enabled  bool `json:"enabled"`
+	Enforced bool `json:"enforced"`
+}
+
+type samlConfigResponse struct {
+	WorkspaceID     string   `json:"workspaceId"`
+	Enabled         bool     `json:"enabled"`
+	Enforced        bool     `json:"enforced"`
+	IdpEntityID     *string  `json:"idpEntityId"`
+	IdpSsoURL       *string  `json:"idpSsoUrl"`
+	HasCertificate  bool     `json:"hasCertificate"`
+	SpEntityID      *string  `json:"spEntityId"`
+	AllowedDomains  []string `json:"allowedDomains"`
+	JitProvisioning bool     `json:"jitProvisioning"`
+}
+
+func NewSamlConfigResource() resource.Resource {
+	return &samlConfigResource{}
+}
+
+func (r *samlConfigResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_saml_config"
+}
+
+func (r *samlConfigResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "SAML SSO configuration of the workspace. There is one per workspace; destroying the resource disables SAML and keeps the IdP settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Workspace ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"enforced": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Require SAML login for users whose email domain is in allowed_domains.",
+			},
+			"idp_entity_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"idp_sso_url": schema.StringAttribute{
+				Optional: true,
+			},
+			"idp_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded X.509 signing certificate of the IdP. The API does not return it, so only its removal is detected on refresh.",
+				Validators: []validator.String{
+					pemCertificateValidator{warnWithin: samlCertificateWarnWithin},
+				},
+			},
+			"allowed_domains": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Lowercase email domains that sign in through SAML.",
+			},
+			"jit_provisioning": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Create users on their first SAML login.",
+			},
+			"sp_entity_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sp_metadata": schema.StringAttribute{
+				Computed:    true,
+				Description: "Service provider metadata XML to register with the IdP.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *samlConfigResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *samlConfigResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config samlConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Enforced.ValueBool() || config.AllowedDomains.IsUnknown() {
+		return
+	}
+
+	if len(config.AllowedDomains.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("allowed_domains"),
+			"SAML Enforcement Has No Effect",
+			"enforced = true only applies to users whose email domain is in allowed_domains. With no domains, nobody is required to sign in through SAML.",
+		)
+	}
+}
+
+func (r *samlConfigResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan samlConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.putSamlConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *samlConfigResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state samlConfigModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.readSamlConfig(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *samlConfigResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan samlConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.putSamlConfig(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *samlConfigResource) Delete(
+	ctx context.Context,
+	_ resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	payload := samlConfigDisablePayload{Enabled: false, Enforced: false}
+	err := r.client.DoJSON(ctx, http.MethodPut, "/api/saml/config", payload, uuid.NewString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *samlConfigResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *samlConfigResource) putSamlConfig(
+	ctx context.Context,
+	plan samlConfigModel,
+) (samlConfigModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	domains, setDiags := expandStringSet(plan.AllowedDomains)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return samlConfigModel{}, diags
+	}
+	if domains == nil {
+		domains = []string{}
+	}
+
+	payload := samlConfigPayload{
+		Enabled:         plan.Enabled.ValueBool(),
+		Enforced:        plan.Enforced.ValueBool(),
+		IdpEntityID:     plan.IdpEntityID.ValueStringPointer(),
+		IdpSsoURL:       plan.IdpSsoURL.ValueStringPointer(),
+		IdpCertificate:  plan.IdpCertificate.ValueStringPointer(),
+		AllowedDomains:  domains,
+		JitProvisioning: plan.JitProvisioning.ValueBool(),
+	}
+
+	err := r.client.DoJSON(ctx, http.MethodPut, "/api/saml/config", payload, uuid.NewString(), nil)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return samlConfigModel{}, diags
+	}
+
+	state, readDiags := r.readSamlConfig(ctx, plan)
+	diags.Append(readDiags...)
+	return state, diags
+}
+
+func (r *samlConfigResource) readSamlConfig(
+	ctx context.Context,
+	prior samlConfigModel,
+) (samlConfigModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp samlConfigResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/saml/config", nil, "", &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return samlConfigModel{}, diags
+	}
+
+	state, flattenDiags := flattenSamlConfig(ctx, apiResp, prior)
+	diags.Append(flattenDiags...)
+
+	if apiResp.SpEntityID == nil {
+		state.SpMetadata = types.StringNull()
+		return state, diags
+	}
+
+	metadata, err := r.client.GetText(ctx, fmt.Sprintf("/api/saml/metadata/%s", apiResp.WorkspaceID))
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return samlConfigModel{}, diags
+	}
+	state.SpMetadata = types.StringValue(metadata)
+
+	return state, diags
+}
+
+func flattenSamlConfig(
+	ctx context.Context,
+	apiResp samlConfigResponse,
+	prior samlConfigModel,
+) (samlConfigModel, diag.Diagnostics) {
+	domains, diags := stringSetValue(ctx, prior.AllowedDomains, apiResp.AllowedDomains)
+
+	certificate := prior.IdpCertificate
+	if !apiResp.HasCertificate || certificate.IsUnknown() {
+		certificate = types.StringNull()
+	}
+
+	return samlConfigModel{
+		ID:              types.StringValue(apiResp.WorkspaceID),
+		Enabled:         types.BoolValue(apiResp.Enabled),
+		Enforced:        types.BoolValue(apiResp.Enforced),
+		IdpEntityID:     types.StringPointerValue(apiResp.IdpEntityID),
+		IdpSsoURL:       types.StringPointerValue(apiResp.IdpSsoURL),
+		IdpCertificate:  certificate,
+		AllowedDomains:  domains,
+		JitProvisioning: types.BoolValue(apiResp.JitProvisioning),
+		SpEntityID:      types.StringPointerValue(apiResp.SpEntityID),
+		SpMetadata:      prior.SpMetadata,
+	}, diags
+}
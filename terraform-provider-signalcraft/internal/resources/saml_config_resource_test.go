@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSamlConfigValidateConfigWarnsOnEnforcementWithoutDomains(t *testing.T) {
+	r := &samlConfigResource{}
+	samlConfig := func(enforced bool, domains ...string) samlConfigModel {
+		values := make([]attr.Value, 0, len(domains))
+		for _, domain := range domains {
+			values = append(values, types.StringValue(domain))
+		}
+		return testModel(t, r, func(m *samlConfigModel) {
+			m.Enabled = types.BoolValue(true)
+			m.Enforced = types.BoolValue(enforced)
+			m.IdpEntityID = types.StringValue("https://idp.example.com")
+			m.IdpSsoURL = types.StringValue("https://idp.example.com/sso/saml")
+			m.AllowedDomains = types.SetValueMust(types.StringType, values)
+		})
+	}
+
+	diags := validateConfig(t, r, samlConfig(true))
+	requireNoDiags(t, diags)
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected an enforcement warning, got %v", diags)
+	}
+
+	for _, config := range []samlConfigModel{samlConfig(true, "example.com"), samlConfig(false)} {
+		diags = validateConfig(t, r, config)
+		if diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type serviceAccountKeyResource struct {
+	client *client.Client
+}
+
+type serviceAccountKeyModel struct {
+	ID               types.String `tfsdk:"id"`
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	Name             types.String `tfsdk:"name"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	Keepers          types.Map    `tfsdk:"keepers"`
+	Prefix           types.String `tfsdk:"prefix"`
+	Key              types.String `tfsdk:"key"`
+}
+
+type serviceAccountKeyPayload struct {
+	Name      string  `json:"name"`
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+}
+
+type serviceAccountKeyResponse struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Prefix    string  `json:"prefix"`
+	Key       *string `json:"key"`
+	ExpiresAt *string `json:"expiresAt"`
+}
+
+func NewServiceAccountKeyResource() resource.Resource {
+	return &serviceAccountKeyResource{}
+}
+
+func (r *serviceAccountKeyResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_service_account_key"
+}
+
+func (r *serviceAccountKeyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "API key for a service account. Every change replaces the key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_account_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{rfc3339Validator{}},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Expiry of the key. Expired keys are removed from state on refresh so the next apply issues a new one.",
+			},
+			"keepers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+				Description: "Arbitrary values that rotate the key when changed. Not sent to the API.",
+			},
+			"prefix": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Plaintext key. Only set in the apply that creates the key; later refreshes clear it and keep prefix.",
+			},
+		},
+	}
+}
+
+func (r *serviceAccountKeyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *serviceAccountKeyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan serviceAccountKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := serviceAccountKeyPayload{
+		Name:      plan.Name.ValueString(),
+		ExpiresAt: plan.ExpiresAt.ValueStringPointer(),
+	}
+
+	var apiResp serviceAccountKeyResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/api/service-accounts/%s/keys", plan.ServiceAccountID.ValueString()),
+		payload,
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	state := flattenServiceAccountKey(apiResp, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *serviceAccountKeyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state serviceAccountKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keys []serviceAccountKeyResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/service-accounts/%s/keys", state.ServiceAccountID.ValueString()),
+		nil,
+		"",
+		&keys,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	for _, key := range keys {
+		if key.ID != state.ID.ValueString() {
+			continue
+		}
+
+		if key.ExpiresAt != nil {
+			if expiresAt, err := time.Parse(time.RFC3339, *key.ExpiresAt); err == nil && !expiresAt.After(time.Now()) {
+				break
+			}
+		}
+
+		newState := flattenServiceAccountKey(key, state)
+		newState.Key = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *serviceAccountKeyResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	resp.Diagnostics.AddError(
+		"Unsupported",
+		"Updating service account keys is not supported. Every change replaces the key.",
+	)
+}
+
+func (r *serviceAccountKeyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state serviceAccountKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf(
+			"/api/service-accounts/%s/keys/%s",
+			state.ServiceAccountID.ValueString(),
+			state.ID.ValueString(),
+		),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *serviceAccountKeyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected <service_account_id>/<key_id>.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_account_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func flattenServiceAccountKey(
+	apiResp serviceAccountKeyResponse,
+	prior serviceAccountKeyModel,
+) serviceAccountKeyModel {
+	return serviceAccountKeyModel{
+		ID:               types.StringValue(apiResp.ID),
+		ServiceAccountID: prior.ServiceAccountID,
+		Name:             types.StringValue(apiResp.Name),
+		ExpiresAt:        timestampValue(prior.ExpiresAt, apiResp.ExpiresAt),
+		Keepers:          prior.Keepers,
+		Prefix:           types.StringValue(apiResp.Prefix),
+		Key:              types.StringPointerValue(apiResp.Key),
+	}
+}
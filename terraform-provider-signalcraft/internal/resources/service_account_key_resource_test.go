@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func readServiceAccountKey(t *testing.T, expiresAt *string) resource.ReadResponse {
+	t.Helper()
+	r := &serviceAccountKeyResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/service-accounts/sa_1/keys" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewEncoder(w).Encode([]serviceAccountKeyResponse{
+			{ID: "key_1", Name: "deploy", Prefix: "sk_live_1234abcd", ExpiresAt: expiresAt},
+		})
+	})}
+
+	state := testState(t, r, testModel(t, r, func(m *serviceAccountKeyModel) {
+		m.ID = types.StringValue("key_1")
+		m.ServiceAccountID = types.StringValue("sa_1")
+		m.Name = types.StringValue("deploy")
+		m.ExpiresAt = types.StringPointerValue(expiresAt)
+		m.Keepers = types.MapValueMust(types.StringType, map[string]attr.Value{"rotation": types.StringValue("2026-q4")})
+		m.Prefix = types.StringValue("sk_live_1234abcd")
+		m.Key = types.StringValue("sk_live_secret")
+	}))
+	resp := resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	requireNoDiags(t, resp.Diagnostics)
+	return resp
+}
+
+func TestServiceAccountKeyReadClearsPlaintextKey(t *testing.T) {
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	resp := readServiceAccountKey(t, &expiresAt)
+
+	var state serviceAccountKeyModel
+	requireNoDiags(t, resp.State.Get(context.Background(), &state))
+	if !state.Key.IsNull() || state.Prefix.ValueString() != "sk_live_1234abcd" || len(state.Keepers.Elements()) != 1 {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+}
+
+func TestServiceAccountKeyReadDropsExpiredKey(t *testing.T) {
+	expiresAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	resp := readServiceAccountKey(t, &expiresAt)
+
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected an expired key to be removed from state")
+	}
+}
@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServiceAccountUpdateClearsDescription(t *testing.T) {
+	var sent map[string]interface{}
+	r := &serviceAccountResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/service-accounts/sa_1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&sent)
+		_ = json.NewEncoder(w).Encode(serviceAccountResponse{ID: "sa_1", Name: "CI"})
+	})}
+
+	prior := serviceAccountModel{
+		ID:          types.StringValue("sa_1"),
+		Name:        types.StringValue("CI"),
+		Description: types.StringValue("Deploys from CI"),
+	}
+	plan := prior
+	plan.Description = types.StringNull()
+
+	req := resource.UpdateRequest{
+		Config: testConfig(t, r, plan),
+		Plan:   testPlan(t, r, plan),
+		State:  testState(t, r, prior),
+	}
+	resp := resource.UpdateResponse{State: req.State}
+	r.Update(context.Background(), req, &resp)
+	requireNoDiags(t, resp.Diagnostics)
+
+	if value, ok := sent["description"]; !ok || value != nil {
+		t.Fatalf("expected description to be sent as null, got %v", sent)
+	}
+
+	var state serviceAccountModel
+	requireNoDiags(t, resp.State.Get(context.Background(), &state))
+	if !state.Description.IsNull() {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+}
@@ -0,0 +1,230 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type slackIntegrationResource struct {
+	client *client.Client
+}
+
+type slackIntegrationModel struct {
+	ID             types.String `tfsdk:"id"`
+	DefaultChannel types.String `tfsdk:"default_channel"`
+	Status         types.String `tfsdk:"status"`
+}
+
+type slackDefaultChannelPayload struct {
+	ChannelID string `json:"channelId"`
+}
+
+type slackIntegrationStatusResponse struct {
+	Connected      bool    `json:"connected"`
+	Status         *string `json:"status"`
+	DefaultChannel *string `json:"defaultChannel"`
+}
+
+func NewSlackIntegrationResource() resource.Resource {
+	return &slackIntegrationResource{}
+}
+
+func (r *slackIntegrationResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_slack_integration"
+}
+
+func (r *slackIntegrationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Settings of the workspace's Slack integration. Slack itself is connected through OAuth in the web app; destroying the resource leaves it connected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_channel": schema.StringAttribute{
+				Required:    true,
+				Description: "Channel ID, such as C0123456789, that alerts are posted to by default.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "Integration status. A disconnected integration removes the resource from state.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *slackIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *slackIntegrationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan slackIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, diags := r.getStatus(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !apiResp.Connected {
+		resp.Diagnostics.AddError(
+			"Slack Not Connected",
+			"Connect Slack to the workspace in the SignalCraft web app before managing its settings.",
+		)
+		return
+	}
+
+	state, diags := r.setDefaultChannel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *slackIntegrationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state slackIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, diags := r.getStatus(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !apiResp.Connected {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenSlackIntegration(apiResp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *slackIntegrationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan slackIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.setDefaultChannel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *slackIntegrationResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+	return
+}
+
+func (r *slackIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *slackIntegrationResource) getStatus(ctx context.Context) (slackIntegrationStatusResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp slackIntegrationStatusResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/integrations/slack/status", nil, "", &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+	}
+	return apiResp, diags
+}
+
+func (r *slackIntegrationResource) setDefaultChannel(
+	ctx context.Context,
+	plan slackIntegrationModel,
+) (slackIntegrationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := slackDefaultChannelPayload{ChannelID: plan.DefaultChannel.ValueString()}
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPost,
+		"/api/integrations/slack/default-channel",
+		payload,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return slackIntegrationModel{}, diags
+	}
+
+	apiResp, statusDiags := r.getStatus(ctx)
+	diags.Append(statusDiags...)
+	if diags.HasError() {
+		return slackIntegrationModel{}, diags
+	}
+
+	state := flattenSlackIntegration(apiResp)
+	state.DefaultChannel = plan.DefaultChannel
+	return state, diags
+}
+
+func flattenSlackIntegration(apiResp slackIntegrationStatusResponse) slackIntegrationModel {
+	return slackIntegrationModel{
+		ID:             types.StringValue("slack"),
+		DefaultChannel: types.StringPointerValue(apiResp.DefaultChannel),
+		Status:         types.StringPointerValue(apiResp.Status),
+	}
+}
@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testSlackServer(t *testing.T, status *slackIntegrationStatusResponse) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/integrations/slack/status":
+			_ = json.NewEncoder(w).Encode(status)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/integrations/slack/default-channel":
+			var payload slackDefaultChannelPayload
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			status.DefaultChannel = &payload.ChannelID
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestSlackIntegrationCreateRequiresConnection(t *testing.T) {
+	status := slackIntegrationStatusResponse{}
+	r := &slackIntegrationResource{client: testClient(t, testSlackServer(t, &status))}
+	plan := testModel(t, r, func(m *slackIntegrationModel) {
+		m.DefaultChannel = types.StringValue("C0123456789")
+	})
+
+	_, diags := createResource(t, r, plan)
+	requireErrorSummary(t, diags, "Slack Not Connected")
+	if status.DefaultChannel != nil {
+		t.Fatal("expected no default channel to be set")
+	}
+
+	active := "ACTIVE"
+	status = slackIntegrationStatusResponse{Connected: true, Status: &active}
+	state, diags := createResource(t, r, plan)
+	requireNoDiags(t, diags)
+
+	var created slackIntegrationModel
+	requireNoDiags(t, state.Get(context.Background(), &created))
+	if created.DefaultChannel.ValueString() != "C0123456789" || created.Status.ValueString() != "ACTIVE" {
+		t.Fatalf("unexpected state: %+v", created)
+	}
+}
+
+func TestSlackIntegrationReadTracksConnection(t *testing.T) {
+	active, channel := "ACTIVE", "C0987654321"
+	status := slackIntegrationStatusResponse{Connected: true, Status: &active, DefaultChannel: &channel}
+	r := &slackIntegrationResource{client: testClient(t, testSlackServer(t, &status))}
+	prior := testModel(t, r, func(m *slackIntegrationModel) {
+		m.ID = types.StringValue("slack")
+		m.DefaultChannel = types.StringValue("C0123456789")
+		m.Status = types.StringValue("ACTIVE")
+	})
+
+	resp := readResource(t, r, prior)
+	requireNoDiags(t, resp.Diagnostics)
+	var state slackIntegrationModel
+	requireNoDiags(t, resp.State.Get(context.Background(), &state))
+	if state.DefaultChannel.ValueString() != "C0987654321" {
+		t.Fatalf("expected the channel set outside Terraform, got %s", state.DefaultChannel)
+	}
+
+	status = slackIntegrationStatusResponse{Connected: false}
+	resp = readResource(t, r, prior)
+	requireNoDiags(t, resp.Diagnostics)
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected a disconnected integration to be removed from state")
+	}
+}
@@ -0,0 +1,355 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var statusIncidentStatuses = []string{"INVESTIGATING", "IDENTIFIED", "MONITORING", "RESOLVED"}
+
+var statusIncidentImpacts = []string{"NONE", "MINOR", "MAJOR", "CRITICAL"}
+
+type statusPageMaintenanceResource struct {
+	client *client.Client
+}
+
+type statusPageMaintenanceModel struct {
+	ID           types.String `tfsdk:"id"`
+	StatusPageID types.String `tfsdk:"status_page_id"`
+	Title        types.String `tfsdk:"title"`
+	Status       types.String `tfsdk:"status"`
+	Impact       types.String `tfsdk:"impact"`
+	Message      types.String `tfsdk:"message"`
+	ResolvedAt   types.String `tfsdk:"resolved_at"`
+}
+
+type statusPageIncidentCreatePayload struct {
+	Title   string  `json:"title"`
+	Status  string  `json:"status"`
+	Impact  string  `json:"impact"`
+	Message *string `json:"message,omitempty"`
+}
+
+type statusPageIncidentUpdatePayload struct {
+	Status  string  `json:"status"`
+	Impact  string  `json:"impact"`
+	Message *string `json:"message"`
+}
+
+type statusPageIncidentResponse struct {
+	ID         string  `json:"id"`
+	Title      string  `json:"title"`
+	Status     string  `json:"status"`
+	Impact     string  `json:"impact"`
+	Message    *string `json:"message"`
+	ResolvedAt *string `json:"resolvedAt"`
+}
+
+func NewStatusPageMaintenanceResource() resource.Resource {
+	return &statusPageMaintenanceResource{}
+}
+
+func (r *statusPageMaintenanceResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_status_page_maintenance"
+}
+
+func (r *statusPageMaintenanceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Maintenance notice posted as a status page incident. Destroying the resource resolves the incident.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Incident title. The API cannot rename incidents, so changing it posts a new notice.",
+			},
+			"status": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					oneOfValidator{values: statusIncidentStatuses},
+				},
+			},
+			"impact": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("MINOR"),
+				Validators: []validator.String{
+					oneOfValidator{values: statusIncidentImpacts},
+				},
+			},
+			"message": schema.StringAttribute{
+				Optional: true,
+			},
+			"resolved_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *statusPageMaintenanceResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *statusPageMaintenanceResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan statusPageMaintenanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := statusPageIncidentCreatePayload{
+		Title:   plan.Title.ValueString(),
+		Status:  plan.Status.ValueString(),
+		Impact:  plan.Impact.ValueString(),
+		Message: plan.Message.ValueStringPointer(),
+	}
+
+	var incident *statusPageIncidentResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/api/status-pages/%s/incidents", plan.StatusPageID.ValueString()),
+		payload,
+		uuid.NewString(),
+		&incident,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+	if incident == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status_page_id"),
+			"Status page not found",
+			fmt.Sprintf("Status page %s does not exist in this workspace.", plan.StatusPageID.ValueString()),
+		)
+		return
+	}
+
+	state := flattenStatusPageMaintenance(*incident, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *statusPageMaintenanceResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state statusPageMaintenanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incident, found, diags := r.findIncident(ctx, state.StatusPageID.ValueString(), state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenStatusPageMaintenance(incident, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *statusPageMaintenanceResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan statusPageMaintenanceModel
+	var state statusPageMaintenanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := statusPageIncidentUpdatePayload{
+		Status:  plan.Status.ValueString(),
+		Impact:  plan.Impact.ValueString(),
+		Message: plan.Message.ValueStringPointer(),
+	}
+
+	incident, diags := r.updateIncident(ctx, state.StatusPageID.ValueString(), state.ID.ValueString(), payload)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if incident == nil {
+		resp.Diagnostics.AddError(
+			"Status page incident not found",
+			fmt.Sprintf("Incident %s no longer exists on status page %s.", state.ID.ValueString(), state.StatusPageID.ValueString()),
+		)
+		return
+	}
+
+	newState := flattenStatusPageMaintenance(*incident, plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *statusPageMaintenanceResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state statusPageMaintenanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() == "RESOLVED" {
+		return
+	}
+
+	payload := statusPageIncidentUpdatePayload{
+		Status:  "RESOLVED",
+		Impact:  state.Impact.ValueString(),
+		Message: state.Message.ValueStringPointer(),
+	}
+
+	_, diags := r.updateIncident(ctx, state.StatusPageID.ValueString(), state.ID.ValueString(), payload)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *statusPageMaintenanceResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected <status_page_id>/<incident_id>.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("status_page_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *statusPageMaintenanceResource) findIncident(
+	ctx context.Context,
+	statusPageID string,
+	incidentID string,
+) (statusPageIncidentResponse, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var incidents []statusPageIncidentResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/status-pages/%s/incidents", statusPageID),
+		nil,
+		"",
+		&incidents,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return statusPageIncidentResponse{}, false, diags
+		}
+		diags.AddError("API Error", err.Error())
+		return statusPageIncidentResponse{}, false, diags
+	}
+
+	for _, incident := range incidents {
+		if incident.ID == incidentID {
+			return incident, true, diags
+		}
+	}
+
+	return statusPageIncidentResponse{}, false, diags
+}
+
+func (r *statusPageMaintenanceResource) updateIncident(
+	ctx context.Context,
+	statusPageID string,
+	incidentID string,
+	payload statusPageIncidentUpdatePayload,
+) (*statusPageIncidentResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var incident *statusPageIncidentResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("/api/status-pages/%s/incidents/%s", statusPageID, incidentID),
+		payload,
+		uuid.NewString(),
+		&incident,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return nil, diags
+		}
+		diags.AddError("API Error", err.Error())
+		return nil, diags
+	}
+	return incident, diags
+}
+
+func flattenStatusPageMaintenance(
+	incident statusPageIncidentResponse,
+	prior statusPageMaintenanceModel,
+) statusPageMaintenanceModel {
+	return statusPageMaintenanceModel{
+		ID:           types.StringValue(incident.ID),
+		StatusPageID: prior.StatusPageID,
+		Title:        types.StringValue(incident.Title),
+		Status:       types.StringValue(incident.Status),
+		Impact:       types.StringValue(incident.Impact),
+		Message:      types.StringPointerValue(incident.Message),
+		ResolvedAt:   timestampValue(prior.ResolvedAt, incident.ResolvedAt),
+	}
+}
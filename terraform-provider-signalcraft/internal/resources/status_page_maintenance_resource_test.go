@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testStatusPageMaintenance(t *testing.T, status string) statusPageMaintenanceModel {
+	return testModel(t, &statusPageMaintenanceResource{}, func(m *statusPageMaintenanceModel) {
+		m.ID = types.StringValue("incident_1")
+		m.StatusPageID = types.StringValue("page_1")
+		m.Title = types.StringValue("Database upgrade")
+		m.Status = types.StringValue(status)
+		m.Impact = types.StringValue("MINOR")
+		m.Message = types.StringValue("Writes are paused for ten minutes.")
+		m.ResolvedAt = types.StringNull()
+	})
+}
+
+func TestStatusPageMaintenanceDeleteResolvesIncident(t *testing.T) {
+	var resolved []statusPageIncidentUpdatePayload
+	r := &statusPageMaintenanceResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/api/status-pages/page_1/incidents/incident_1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		var payload statusPageIncidentUpdatePayload
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		resolved = append(resolved, payload)
+		_ = json.NewEncoder(w).Encode(statusPageIncidentResponse{ID: "incident_1", Status: payload.Status})
+	})}
+
+	requireNoDiags(t, deleteResource(t, r, testStatusPageMaintenance(t, "IDENTIFIED")))
+	requireNoDiags(t, deleteResource(t, r, testStatusPageMaintenance(t, "RESOLVED")))
+
+	if len(resolved) != 1 || resolved[0].Status != "RESOLVED" || resolved[0].Impact != "MINOR" {
+		t.Fatalf("expected one incident to be resolved, got %+v", resolved)
+	}
+}
+
+func TestStatusPageMaintenanceCreateOnMissingPage(t *testing.T) {
+	r := &statusPageMaintenanceResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("null"))
+	})}
+
+	_, diags := createResource(t, r, testStatusPageMaintenance(t, "INVESTIGATING"))
+	requireErrorSummary(t, diags, "Status page not found")
+}
+
+func TestStatusPageMaintenanceImportState(t *testing.T) {
+	r := &statusPageMaintenanceResource{}
+	importState := func(id string) resource.ImportStateResponse {
+		resp := resource.ImportStateResponse{State: testState(t, r, nil)}
+		r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, &resp)
+		return resp
+	}
+
+	resp := importState("page_1/incident_1")
+	requireNoDiags(t, resp.Diagnostics)
+	var pageID, id types.String
+	requireNoDiags(t, resp.State.GetAttribute(context.Background(), path.Root("status_page_id"), &pageID))
+	requireNoDiags(t, resp.State.GetAttribute(context.Background(), path.Root("id"), &id))
+	if pageID.ValueString() != "page_1" || id.ValueString() != "incident_1" {
+		t.Fatalf("unexpected import: %s, %s", pageID, id)
+	}
+
+	requireErrorSummary(t, importState("incident_1").Diagnostics, "Invalid Import ID")
+}
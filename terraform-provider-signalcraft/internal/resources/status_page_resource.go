@@ -0,0 +1,360 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var statusPageSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type statusPageResource struct {
+	client *client.Client
+}
+
+type statusPageModel struct {
+	ID          types.String `tfsdk:"id"`
+	Slug        types.String `tfsdk:"slug"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Visibility  types.String `tfsdk:"visibility"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	PublicURL   types.String `tfsdk:"public_url"`
+}
+
+type statusPageCreatePayload struct {
+	Slug        string  `json:"slug"`
+	Title       string  `json:"title"`
+	Description *string `json:"description,omitempty"`
+	Visibility  string  `json:"visibility"`
+}
+
+type statusPageUpdatePayload struct {
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	Visibility  string  `json:"visibility"`
+	IsActive    bool    `json:"isActive"`
+}
+
+type statusPageResponse struct {
+	ID          string  `json:"id"`
+	Slug        string  `json:"slug"`
+	Title       string  `json:"title"`
+	Description *string `json:"description"`
+	Visibility  string  `json:"visibility"`
+	IsActive    bool    `json:"isActive"`
+}
+
+func NewStatusPageResource() resource.Resource {
+	return &statusPageResource{}
+}
+
+func (r *statusPageResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_status_page"
+}
+
+func (r *statusPageResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Status page. The API has no delete route, so destroying the resource deactivates the page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slug": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					patternValidator{
+						pattern: statusPageSlugPattern,
+						message: "slug must contain only lowercase letters, digits and single hyphens",
+					},
+				},
+				Description: "Globally unique slug used in the public URL.",
+			},
+			"title": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"visibility": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("PUBLIC"),
+				Validators: []validator.String{
+					oneOfValidator{values: []string{"PUBLIC", "PRIVATE"}},
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"public_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *statusPageResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *statusPageResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan statusPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pages, diags := r.listStatusPages(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pageID := ""
+	for _, page := range pages {
+		if page.Slug != plan.Slug.ValueString() {
+			continue
+		}
+		if page.IsActive {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slug"),
+				"Status Page Already Exists",
+				fmt.Sprintf(
+					"An active status page with slug %q already exists in this workspace (id %s). Import it with terraform import.",
+					page.Slug,
+					page.ID,
+				),
+			)
+			return
+		}
+		pageID = page.ID
+	}
+
+	if pageID == "" {
+		payload := statusPageCreatePayload{
+			Slug:        plan.Slug.ValueString(),
+			Title:       plan.Title.ValueString(),
+			Description: plan.Description.ValueStringPointer(),
+			Visibility:  plan.Visibility.ValueString(),
+		}
+
+		var created statusPageResponse
+		err := r.client.DoJSON(ctx, http.MethodPost, "/api/status-pages", payload, uuid.NewString(), &created)
+		if err != nil {
+			if isSlugConflict(err) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("slug"),
+					"Status Page Slug Taken",
+					fmt.Sprintf("The slug %q is already used by another workspace. Slugs are globally unique.", plan.Slug.ValueString()),
+				)
+				return
+			}
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
+		pageID = created.ID
+	}
+
+	page, diags := r.updateStatusPage(ctx, pageID, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := r.flattenStatusPage(page)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *statusPageResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state statusPageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pages, diags := r.listStatusPages(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, page := range pages {
+		if page.ID == state.ID.ValueString() {
+			newState := r.flattenStatusPage(page)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *statusPageResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan statusPageModel
+	var state statusPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, diags := r.updateStatusPage(ctx, state.ID.ValueString(), plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState := r.flattenStatusPage(page)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *statusPageResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state statusPageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("/api/status-pages/%s", state.ID.ValueString()),
+		map[string]bool{"isActive": false},
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *statusPageResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *statusPageResource) listStatusPages(ctx context.Context) ([]statusPageResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var pages []statusPageResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/status-pages", nil, "", &pages)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return nil, diags
+	}
+	return pages, diags
+}
+
+func (r *statusPageResource) updateStatusPage(
+	ctx context.Context,
+	pageID string,
+	plan statusPageModel,
+) (statusPageResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := statusPageUpdatePayload{
+		Title:       plan.Title.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		Visibility:  plan.Visibility.ValueString(),
+		IsActive:    plan.IsActive.ValueBool(),
+	}
+
+	var page *statusPageResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("/api/status-pages/%s", pageID),
+		payload,
+		uuid.NewString(),
+		&page,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return statusPageResponse{}, diags
+	}
+	if page == nil {
+		diags.AddError("Status page not found", fmt.Sprintf("Status page %s does not exist in this workspace.", pageID))
+		return statusPageResponse{}, diags
+	}
+
+	return *page, diags
+}
+
+func (r *statusPageResource) flattenStatusPage(page statusPageResponse) statusPageModel {
+	return statusPageModel{
+		ID:          types.StringValue(page.ID),
+		Slug:        types.StringValue(page.Slug),
+		Title:       types.StringValue(page.Title),
+		Description: types.StringPointerValue(page.Description),
+		Visibility:  types.StringValue(page.Visibility),
+		IsActive:    types.BoolValue(page.IsActive),
+		PublicURL:   types.StringValue(fmt.Sprintf("%s/status/%s", r.client.WebURL, page.Slug)),
+	}
+}
+
+func isSlugConflict(err error) bool {
+	httpErr, ok := err.(*client.HTTPError)
+	return ok && httpErr.StatusCode == http.StatusConflict
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+func testStatusPage(t *testing.T) statusPageModel {
+	return testModel(t, &statusPageResource{}, func(m *statusPageModel) {
+		m.Slug = types.StringValue("acme")
+		m.Title = types.StringValue("Acme Status")
+		m.Visibility = types.StringValue("PUBLIC")
+		m.IsActive = types.BoolValue(true)
+	})
+}
+
+func testStatusPageClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	t.Helper()
+	c := testClient(t, handler)
+	c.WebURL = "https://app.example.com"
+	return c
+}
+
+func TestStatusPageCreateReactivatesDeactivatedPage(t *testing.T) {
+	var patched []string
+	r := &statusPageResource{client: testStatusPageClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/status-pages":
+			_ = json.NewEncoder(w).Encode([]statusPageResponse{{ID: "page_1", Slug: "acme", IsActive: false}})
+		case r.Method == http.MethodPatch:
+			patched = append(patched, r.URL.Path)
+			var payload statusPageUpdatePayload
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			_ = json.NewEncoder(w).Encode(statusPageResponse{
+				ID:         "page_1",
+				Slug:       "acme",
+				Title:      payload.Title,
+				Visibility: payload.Visibility,
+				IsActive:   payload.IsActive,
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})}
+
+	state, diags := createResource(t, r, testStatusPage(t))
+	requireNoDiags(t, diags)
+	if len(patched) != 1 || patched[0] != "/api/status-pages/page_1" {
+		t.Fatalf("expected the deactivated page to be updated, got %v", patched)
+	}
+
+	var got statusPageModel
+	requireNoDiags(t, state.Get(context.Background(), &got))
+	if !got.IsActive.ValueBool() || got.PublicURL.ValueString() != "https://app.example.com/status/acme" {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestStatusPageCreateRejectsActiveSlug(t *testing.T) {
+	r := &statusPageResource{client: testStatusPageClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]statusPageResponse{{ID: "page_1", Slug: "acme", IsActive: true}})
+	})}
+
+	_, diags := createResource(t, r, testStatusPage(t))
+	requireErrorSummary(t, diags, "Status Page Already Exists")
+}
+
+func TestStatusPageCreateReportsGlobalSlugConflict(t *testing.T) {
+	r := &statusPageResource{client: testStatusPageClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode([]statusPageResponse{})
+			return
+		}
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message":"Status page slug \"acme\" is already taken"}`))
+	})}
+
+	_, diags := createResource(t, r, testStatusPage(t))
+	requireErrorSummary(t, diags, "Status Page Slug Taken")
+}
+
+func TestStatusPageCreateReportsServerErrorsAsIs(t *testing.T) {
+	r := &statusPageResource{client: testStatusPageClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode([]statusPageResponse{})
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"unique index rebuild in progress"}`))
+	})}
+
+	_, diags := createResource(t, r, testStatusPage(t))
+	requireErrorSummary(t, diags, "API Error")
+}
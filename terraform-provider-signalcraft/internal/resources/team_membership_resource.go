@@ -0,0 +1,251 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+type teamMembershipResource struct {
+	client *client.Client
+}
+
+type teamMembershipModel struct {
+	ID     types.String `tfsdk:"id"`
+	TeamID types.String `tfsdk:"team_id"`
+	UserID types.String `tfsdk:"user_id"`
+}
+
+type teamMemberResponse struct {
+	ID string `json:"id"`
+}
+
+func NewTeamMembershipResource() resource.Resource {
+	return &teamMembershipResource{}
+}
+
+func (r *teamMembershipResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_team_membership"
+}
+
+func (r *teamMembershipResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Adds one user to a team without managing the team's other members. Do not combine with the members attribute of signalcraft_team for the same team, which removes every member it does not list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *teamMembershipResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *teamMembershipResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan teamMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := plan.TeamID.ValueString()
+	userID := plan.UserID.ValueString()
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/api/teams/%s/members", teamID),
+		teamMemberPayload{UserID: userID},
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		httpErr, ok := err.(*client.HTTPError)
+		if !ok || httpErr.StatusCode != http.StatusBadRequest {
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
+
+		found, diags := r.hasMember(ctx, teamID, userID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_id"),
+			"Membership Already Exists",
+			fmt.Sprintf(
+				"User %s is already a member of team %s. Import the membership with the ID %s/%s to manage it.",
+				userID, teamID, teamID, userID,
+			),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(teamID + "/" + userID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *teamMembershipResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state teamMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.hasMember(ctx, state.TeamID.ValueString(), state.UserID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.TeamID.ValueString() + "/" + state.UserID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *teamMembershipResource) Update(
+	_ context.Context,
+	_ resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	resp.Diagnostics.AddError(
+		"Unsupported",
+		"Updating team memberships is not supported. Changing team_id or user_id replaces the membership.",
+	)
+}
+
+func (r *teamMembershipResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state teamMembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/teams/%s/members/%s", state.TeamID.ValueString(), state.UserID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *teamMembershipResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected <team_id>/<user_id>.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
+
+func (r *teamMembershipResource) hasMember(
+	ctx context.Context,
+	teamID string,
+	userID string,
+) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var members []teamMemberResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/teams/%s/members", teamID),
+		nil,
+		"",
+		&members,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return false, diags
+		}
+		diags.AddError("API Error", err.Error())
+		return false, diags
+	}
+
+	for _, member := range members {
+		if member.ID == userID {
+			return true, diags
+		}
+	}
+	return false, diags
+}
@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testTeamMembership(t *testing.T) teamMembershipModel {
+	return testModel(t, &teamMembershipResource{}, func(m *teamMembershipModel) {
+		m.TeamID = types.StringValue("team_1")
+		m.UserID = types.StringValue("user_1")
+	})
+}
+
+func TestTeamMembershipCreateRefusesExistingMember(t *testing.T) {
+	r := &teamMembershipResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			w.WriteHeader(http.StatusBadRequest)
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode([]teamMemberResponse{{ID: "user_1"}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})}
+
+	_, diags := createResource(t, r, testTeamMembership(t))
+	requireErrorSummary(t, diags, "Membership Already Exists")
+}
+
+func TestTeamMembershipCreate(t *testing.T) {
+	r := &teamMembershipResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/teams/team_1/members" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		var payload teamMemberPayload
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		if payload.UserID != "user_1" {
+			t.Errorf("unexpected user %q", payload.UserID)
+		}
+	})}
+
+	state, diags := createResource(t, r, testTeamMembership(t))
+	requireNoDiags(t, diags)
+
+	var got teamMembershipModel
+	requireNoDiags(t, state.Get(context.Background(), &got))
+	if got.ID.ValueString() != "team_1/user_1" {
+		t.Fatalf("unexpected id %q", got.ID.ValueString())
+	}
+}
+
+func TestTeamMembershipDeleteIgnoresMissingMember(t *testing.T) {
+	r := &teamMembershipResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/teams/team_1/members/user_1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})}
+
+	state := testTeamMembership(t)
+	state.ID = types.StringValue("team_1/user_1")
+	requireNoDiags(t, deleteResource(t, r, state))
+}
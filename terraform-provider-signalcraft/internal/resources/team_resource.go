@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -17,6 +20,8 @@ import (
 	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
 )
 
+const teamMemberConcurrency = 5
+
 type teamResource struct {
 	client *client.Client
 }
@@ -90,7 +95,7 @@ func (r *teamResource) Schema(
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.UseStateForUnknown(),
 				},
-				Description: "Set of user IDs to include in the team.",
+				Description: "Set of user IDs to include in the team. When set, members not listed are removed; leave it unset to manage members with signalcraft_team_membership instead.",
 			},
 		},
 	}
@@ -107,6 +112,54 @@ func (r *teamResource) Configure(
 	r.client = req.ProviderData.(*client.Client)
 }
 
+func (r *teamResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan teamModel
+	var state teamModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Members.IsNull() || plan.Members.IsUnknown() {
+		return
+	}
+
+	current, diags := r.readTeamState(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, diags := expandStringSet(current.Members)
+	resp.Diagnostics.Append(diags...)
+	desired, diags := expandStringSet(plan.Members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, toRemove := diffStringSets(existing, desired)
+	if len(toRemove) == 0 {
+		return
+	}
+	sort.Strings(toRemove)
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("members"),
+		"Team Members Will Be Removed",
+		fmt.Sprintf(
+			"Users %s are on team %q but not in members, so this apply removes them. "+
+				"If they are managed by signalcraft_team_membership, leave members unset on this team.",
+			strings.Join(toRemove, ", "),
+			plan.Name.ValueString(),
+		),
+	)
+}
+
 func (r *teamResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -132,35 +185,25 @@ func (r *teamResource) Create(
 	}
 
 	teamID := apiResp.ID
+	var memberDiags diag.Diagnostics
 	if !plan.Members.IsNull() && !plan.Members.IsUnknown() {
-		memberIDs, diag := expandStringSet(plan.Members)
-		resp.Diagnostics.Append(diag...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		for _, userID := range memberIDs {
-			err := r.client.DoJSON(
-				ctx,
-				http.MethodPost,
-				fmt.Sprintf("/api/teams/%s/members", teamID),
-				teamMemberPayload{UserID: userID},
-				uuid.NewString(),
-				nil,
-			)
-			if err != nil {
-				resp.Diagnostics.AddError("API Error", err.Error())
-				return
-			}
+		memberIDs, diags := expandStringSet(plan.Members)
+		memberDiags.Append(diags...)
+		if !memberDiags.HasError() {
+			memberDiags.Append(r.changeTeamMembers(ctx, teamID, http.MethodPost, memberIDs)...)
 		}
 	}
 
 	state, diags := r.readTeamState(ctx, teamID)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(memberDiags...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamID)...)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(memberDiags...)
 }
 
 func (r *teamResource) Read(
@@ -215,57 +258,32 @@ func (r *teamResource) Update(
 		return
 	}
 
+	var memberDiags diag.Diagnostics
 	if !plan.Members.IsNull() && !plan.Members.IsUnknown() {
-		desired, diag := expandStringSet(plan.Members)
-		resp.Diagnostics.Append(diag...)
+		desired, diags := expandStringSet(plan.Members)
+		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		existing, diag := expandStringSet(state.Members)
-		resp.Diagnostics.Append(diag...)
+		existing, diags := expandStringSet(state.Members)
+		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
 		toAdd, toRemove := diffStringSets(existing, desired)
-
-		for _, userID := range toAdd {
-			err := r.client.DoJSON(
-				ctx,
-				http.MethodPost,
-				fmt.Sprintf("/api/teams/%s/members", state.ID.ValueString()),
-				teamMemberPayload{UserID: userID},
-				uuid.NewString(),
-				nil,
-			)
-			if err != nil {
-				resp.Diagnostics.AddError("API Error", err.Error())
-				return
-			}
-		}
-
-		for _, userID := range toRemove {
-			err := r.client.DoJSON(
-				ctx,
-				http.MethodDelete,
-				fmt.Sprintf("/api/teams/%s/members/%s", state.ID.ValueString(), userID),
-				nil,
-				uuid.NewString(),
-				nil,
-			)
-			if err != nil {
-				resp.Diagnostics.AddError("API Error", err.Error())
-				return
-			}
-		}
+		memberDiags.Append(r.changeTeamMembers(ctx, state.ID.ValueString(), http.MethodPost, toAdd)...)
+		memberDiags.Append(r.changeTeamMembers(ctx, state.ID.ValueString(), http.MethodDelete, toRemove)...)
 	}
 
 	newState, diags := r.readTeamState(ctx, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(memberDiags...)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(memberDiags...)
 }
 
 func (r *teamResource) Delete(
@@ -304,6 +322,70 @@ func (r *teamResource) ImportState(
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *teamResource) changeTeamMembers(
+	ctx context.Context,
+	teamID string,
+	method string,
+	userIDs []string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, teamMemberConcurrency)
+
+	for _, userID := range userIDs {
+		userID := userID
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var err error
+			if method == http.MethodDelete {
+				err = r.client.DoJSON(
+					ctx,
+					http.MethodDelete,
+					fmt.Sprintf("/api/teams/%s/members/%s", teamID, userID),
+					nil,
+					uuid.NewString(),
+					nil,
+				)
+				if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+					err = nil
+				}
+			} else {
+				err = r.client.DoJSON(
+					ctx,
+					http.MethodPost,
+					fmt.Sprintf("/api/teams/%s/members", teamID),
+					teamMemberPayload{UserID: userID},
+					uuid.NewString(),
+					nil,
+				)
+			}
+			if err == nil {
+				return
+			}
+
+			action := "add"
+			if method == http.MethodDelete {
+				action = "remove"
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			diags.AddAttributeError(
+				path.Root("members"),
+				"Team Member Error",
+				fmt.Sprintf("Could not %s user %s: %s", action, userID, err),
+			)
+		}()
+	}
+
+	wg.Wait()
+	return diags
+}
+
 func (r *teamResource) readTeamState(
 	ctx context.Context,
 	teamID string,
@@ -0,0 +1,128 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTeamCreateKeepsTeamWhenMemberAddsFail(t *testing.T) {
+	var mu sync.Mutex
+	var added []string
+	inFlight, maxInFlight := 0, 0
+	r := &teamResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/teams":
+			_ = json.NewEncoder(w).Encode(teamResponse{ID: "team_1", Name: "Payments"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/teams/team_1/members":
+			var payload teamMemberPayload
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			if !strings.HasPrefix(payload.UserID, "missing") {
+				added = append(added, payload.UserID)
+			}
+			mu.Unlock()
+
+			if strings.HasPrefix(payload.UserID, "missing") {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/api/teams/team_1":
+			mu.Lock()
+			defer mu.Unlock()
+			detail := teamDetailResponse{ID: "team_1", Name: "Payments"}
+			for _, userID := range added {
+				detail.Members = append(detail.Members, struct {
+					ID string `json:"id"`
+				}{ID: userID})
+			}
+			_ = json.NewEncoder(w).Encode(detail)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})}
+
+	var members []attr.Value
+	for i := 0; i < 8; i++ {
+		members = append(members, types.StringValue(fmt.Sprintf("user_%d", i)))
+	}
+	members = append(members, types.StringValue("missing_1"), types.StringValue("missing_2"))
+	plan := teamModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("Payments"),
+		Description: types.StringNull(),
+		Members:     types.SetValueMust(types.StringType, members),
+	}
+
+	state, diags := createResource(t, r, plan)
+	if diags.ErrorsCount() != 2 {
+		t.Fatalf("expected one error per failed member, got %v", diags)
+	}
+	requireErrorSummary(t, diags, "Team Member Error")
+	if maxInFlight > teamMemberConcurrency {
+		t.Fatalf("expected at most %d member requests in flight, got %d", teamMemberConcurrency, maxInFlight)
+	}
+
+	var got teamModel
+	requireNoDiags(t, state.Get(context.Background(), &got))
+	saved, _ := expandStringSet(got.Members)
+	if got.ID.ValueString() != "team_1" || len(saved) != 8 {
+		t.Fatalf("expected the team with the members that were added, got %+v", got)
+	}
+}
+
+func TestDiffStringSets(t *testing.T) {
+	toAdd, toRemove := diffStringSets([]string{"a", "b", "c"}, []string{"b", "c", "d", "e"})
+	sort.Strings(toAdd)
+	sort.Strings(toRemove)
+	if strings.Join(toAdd, ",") != "d,e" || strings.Join(toRemove, ",") != "a" {
+		t.Fatalf("unexpected diff: add %v, remove %v", toAdd, toRemove)
+	}
+}
+
+func TestTeamModifyPlanWarnsAboutRemovedMembers(t *testing.T) {
+	r := &teamResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		detail := teamDetailResponse{ID: "team_1", Name: "Payments"}
+		for _, userID := range []string{"user_1", "user_2", "user_3"} {
+			detail.Members = append(detail.Members, struct {
+				ID string `json:"id"`
+			}{ID: userID})
+		}
+		_ = json.NewEncoder(w).Encode(detail)
+	})}
+	team := func(members ...string) teamModel {
+		return testModel(t, r, func(m *teamModel) {
+			m.ID = types.StringValue("team_1")
+			m.Name = types.StringValue("Payments")
+			m.Members, _ = types.SetValueFrom(context.Background(), types.StringType, members)
+		})
+	}
+
+	resp := modifyPlan(t, r, team("user_1"), team("user_1"))
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.HasError() {
+		t.Fatalf("expected one warning, got %v", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics.Warnings()[0].Detail(); !strings.Contains(detail, "user_2, user_3") {
+		t.Fatalf("expected the warning to name the removed members, got %q", detail)
+	}
+
+	resp = modifyPlan(t, r, team("user_1"), team("user_1", "user_2", "user_3"))
+	requireNoDiags(t, resp.Diagnostics)
+}
@@ -0,0 +1,352 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var (
+	twilioAccountSidPattern = regexp.MustCompile(`^AC[0-9a-fA-F]{32}$`)
+	e164Pattern             = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	twilioTestChannels      = []string{"SMS", "VOICE"}
+)
+
+type twilioSettingsResource struct {
+	client *client.Client
+}
+
+type twilioSettingsModel struct {
+	ID               types.String             `tfsdk:"id"`
+	AccountSid       types.String             `tfsdk:"account_sid"`
+	AuthToken        types.String             `tfsdk:"auth_token"`
+	FromNumber       types.String             `tfsdk:"from_number"`
+	AccountSidMasked types.String             `tfsdk:"account_sid_masked"`
+	Verification     *twilioVerificationModel `tfsdk:"verification"`
+}
+
+type twilioVerificationModel struct {
+	To      types.String `tfsdk:"to"`
+	Channel types.String `tfsdk:"channel"`
+}
+
+type twilioSettingsPayload struct {
+	AccountSid string `json:"accountSid"`
+	AuthToken  string `json:"authToken"`
+	FromNumber string `json:"fromNumber"`
+}
+
+type twilioSettingsResponse struct {
+	Configured       bool   `json:"configured"`
+	AccountSidMasked string `json:"accountSidMasked"`
+	FromNumber       string `json:"fromNumber"`
+}
+
+type twilioTestPayload struct {
+	To      string `json:"to"`
+	Channel string `json:"channel"`
+}
+
+type twilioTestResponse struct {
+	Success bool `json:"success"`
+}
+
+func NewTwilioSettingsResource() resource.Resource {
+	return &twilioSettingsResource{}
+}
+
+func (r *twilioSettingsResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_twilio_settings"
+}
+
+func (r *twilioSettingsResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Twilio credentials used for SMS and voice paging. There is one per workspace; destroying the resource only removes it from state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_sid": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					patternValidator{pattern: twilioAccountSidPattern, message: "Expected a Twilio account SID starting with AC"},
+				},
+			},
+			"auth_token": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The API never returns the token, so changes made outside Terraform are not detected.",
+			},
+			"from_number": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					patternValidator{pattern: e164Pattern, message: "Expected an E.164 phone number such as +15550100"},
+				},
+			},
+			"account_sid_masked": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"verification": schema.SingleNestedBlock{
+				Description: "Sends a test message after every create or update and fails the apply if Twilio rejects it.",
+				Attributes: map[string]schema.Attribute{
+					"to": schema.StringAttribute{
+						Optional: true,
+						Validators: []validator.String{
+							patternValidator{pattern: e164Pattern, message: "Expected an E.164 phone number such as +15550100"},
+						},
+					},
+					"channel": schema.StringAttribute{
+						Optional:    true,
+						Description: "SMS or VOICE. Defaults to SMS.",
+						Validators: []validator.String{
+							oneOfValidator{values: twilioTestChannels},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *twilioSettingsResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *twilioSettingsResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var verification *twilioVerificationModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("verification"), &verification)...)
+	if resp.Diagnostics.HasError() || verification == nil {
+		return
+	}
+
+	if verification.To.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("verification").AtName("to"),
+			"Missing Recipient",
+			"verification requires the phone number to send the test message to.",
+		)
+	}
+}
+
+func (r *twilioSettingsResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var accountSid types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("account_sid"), &accountSid)...)
+	if resp.Diagnostics.HasError() || accountSid.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(
+		ctx,
+		path.Root("account_sid_masked"),
+		maskTwilioAccountSid(accountSid.ValueString()),
+	)...)
+}
+
+func (r *twilioSettingsResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan twilioSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.putTwilioSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(r.verify(ctx, plan.Verification)...)
+}
+
+func (r *twilioSettingsResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state twilioSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp twilioSettingsResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/settings/twilio", nil, "", &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+	if !apiResp.Configured {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := flattenTwilioSettings(apiResp, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *twilioSettingsResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan twilioSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.putTwilioSettings(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(r.verify(ctx, plan.Verification)...)
+}
+
+func (r *twilioSettingsResource) Delete(
+	_ context.Context,
+	_ resource.DeleteRequest,
+	_ *resource.DeleteResponse,
+) {
+	return
+}
+
+func (r *twilioSettingsResource) putTwilioSettings(
+	ctx context.Context,
+	plan twilioSettingsModel,
+) (twilioSettingsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := twilioSettingsPayload{
+		AccountSid: plan.AccountSid.ValueString(),
+		AuthToken:  plan.AuthToken.ValueString(),
+		FromNumber: plan.FromNumber.ValueString(),
+	}
+
+	err := r.client.DoJSON(ctx, http.MethodPut, "/settings/twilio", payload, uuid.NewString(), nil)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return twilioSettingsModel{}, diags
+	}
+
+	var apiResp twilioSettingsResponse
+	err = r.client.DoJSON(ctx, http.MethodGet, "/settings/twilio", nil, "", &apiResp)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return twilioSettingsModel{}, diags
+	}
+
+	return flattenTwilioSettings(apiResp, plan), diags
+}
+
+func (r *twilioSettingsResource) verify(
+	ctx context.Context,
+	verification *twilioVerificationModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if verification == nil {
+		return diags
+	}
+
+	payload := twilioTestPayload{
+		To:      verification.To.ValueString(),
+		Channel: "SMS",
+	}
+	if !verification.Channel.IsNull() {
+		payload.Channel = verification.Channel.ValueString()
+	}
+
+	var apiResp twilioTestResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/settings/twilio/test", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		diags.AddAttributeError(path.Root("verification"), "Twilio Verification Failed", err.Error())
+		return diags
+	}
+	if !apiResp.Success {
+		diags.AddAttributeError(
+			path.Root("verification"),
+			"Twilio Verification Failed",
+			fmt.Sprintf(
+				"The test %s to %s could not be sent. Check account_sid, auth_token and from_number.",
+				payload.Channel,
+				payload.To,
+			),
+		)
+	}
+
+	return diags
+}
+
+func flattenTwilioSettings(apiResp twilioSettingsResponse, prior twilioSettingsModel) twilioSettingsModel {
+	accountSid := prior.AccountSid
+	if maskTwilioAccountSid(accountSid.ValueString()) != apiResp.AccountSidMasked {
+		accountSid = types.StringValue(apiResp.AccountSidMasked)
+	}
+
+	return twilioSettingsModel{
+		ID:               types.StringValue("twilio"),
+		AccountSid:       accountSid,
+		AuthToken:        prior.AuthToken,
+		FromNumber:       types.StringValue(apiResp.FromNumber),
+		AccountSidMasked: types.StringValue(apiResp.AccountSidMasked),
+		Verification:     prior.Verification,
+	}
+}
+
+func maskTwilioAccountSid(accountSid string) string {
+	if len(accountSid) < 4 {
+		return accountSid
+	}
+	return fmt.Sprintf("%s...%s", accountSid[:4], accountSid[len(accountSid)-4:])
+}
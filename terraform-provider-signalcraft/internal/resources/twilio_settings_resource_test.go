@@ -0,0 +1,113 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	testTwilioAccountSid      = "AC0123456789abcdef0123456789abcdef"
+	testTwilioOtherAccountSid = "AC99999999999999999999999999999999"
+)
+
+func testTwilioSettings(t *testing.T, accountSid string, verification *twilioVerificationModel) twilioSettingsModel {
+	return testModel(t, &twilioSettingsResource{}, func(m *twilioSettingsModel) {
+		m.AccountSid = types.StringValue(accountSid)
+		m.AuthToken = types.StringValue("token")
+		m.FromNumber = types.StringValue("+15550100")
+		m.Verification = verification
+	})
+}
+
+func testTwilioServer(t *testing.T, settings *twilioSettingsResponse, testSuccess bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/settings/twilio":
+			var payload twilioSettingsPayload
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			*settings = twilioSettingsResponse{
+				Configured:       true,
+				AccountSidMasked: maskTwilioAccountSid(payload.AccountSid),
+				FromNumber:       payload.FromNumber,
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/settings/twilio":
+			_ = json.NewEncoder(w).Encode(settings)
+		case r.Method == http.MethodPost && r.URL.Path == "/settings/twilio/test":
+			_ = json.NewEncoder(w).Encode(twilioTestResponse{Success: testSuccess})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestTwilioSettingsModifyPlanMasksNewAccountSid(t *testing.T) {
+	r := &twilioSettingsResource{}
+	state := testTwilioSettings(t, testTwilioAccountSid, nil)
+	state.ID = types.StringValue("twilio")
+	state.AccountSidMasked = types.StringValue(maskTwilioAccountSid(testTwilioAccountSid))
+
+	plan := testTwilioSettings(t, testTwilioOtherAccountSid, nil)
+	plan.ID = state.ID
+	resp := modifyPlan(t, r, state, plan)
+	requireNoDiags(t, resp.Diagnostics)
+
+	var masked types.String
+	requireNoDiags(t, resp.Plan.GetAttribute(context.Background(), path.Root("account_sid_masked"), &masked))
+	if masked.ValueString() != "AC99...9999" {
+		t.Fatalf("expected the mask of the new SID, got %s", masked)
+	}
+}
+
+func TestTwilioSettingsCreateVerifiesCredentials(t *testing.T) {
+	verification := &twilioVerificationModel{To: types.StringValue("+15550123"), Channel: types.StringNull()}
+
+	var settings twilioSettingsResponse
+	r := &twilioSettingsResource{client: testClient(t, testTwilioServer(t, &settings, true))}
+	state, diags := createResource(t, r, testTwilioSettings(t, testTwilioAccountSid, verification))
+	requireNoDiags(t, diags)
+
+	var created twilioSettingsModel
+	requireNoDiags(t, state.Get(context.Background(), &created))
+	if created.AccountSid.ValueString() != testTwilioAccountSid || created.AccountSidMasked.ValueString() != "AC01...cdef" {
+		t.Fatalf("unexpected state: %+v", created)
+	}
+
+	r = &twilioSettingsResource{client: testClient(t, testTwilioServer(t, &settings, false))}
+	state, diags = createResource(t, r, testTwilioSettings(t, testTwilioAccountSid, verification))
+	requireErrorSummary(t, diags, "Twilio Verification Failed")
+	if state.Raw.IsNull() {
+		t.Fatal("expected the credentials to be saved when verification fails")
+	}
+}
+
+func TestTwilioSettingsReadShowsOutOfBandChange(t *testing.T) {
+	settings := twilioSettingsResponse{
+		Configured:       true,
+		AccountSidMasked: maskTwilioAccountSid(testTwilioOtherAccountSid),
+		FromNumber:       "+15550100",
+	}
+	r := &twilioSettingsResource{client: testClient(t, testTwilioServer(t, &settings, true))}
+	prior := testTwilioSettings(t, testTwilioAccountSid, nil)
+	prior.ID = types.StringValue("twilio")
+	prior.AccountSidMasked = types.StringValue(maskTwilioAccountSid(testTwilioAccountSid))
+
+	resp := readResource(t, r, prior)
+	requireNoDiags(t, resp.Diagnostics)
+	var state twilioSettingsModel
+	requireNoDiags(t, resp.State.Get(context.Background(), &state))
+	if state.AccountSid.ValueString() != "AC99...9999" {
+		t.Fatalf("expected the masked SID after an outside change, got %s", state.AccountSid)
+	}
+
+	settings = twilioSettingsResponse{Configured: false}
+	resp = readResource(t, r, prior)
+	requireNoDiags(t, resp.Diagnostics)
+	if !resp.State.Raw.IsNull() {
+		t.Fatal("expected unconfigured settings to be removed from state")
+	}
+}
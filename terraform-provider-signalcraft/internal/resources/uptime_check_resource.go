@@ -0,0 +1,490 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+const (
+	uptimeDefaultInterval = 60
+	uptimeDefaultTimeout  = 30
+)
+
+type uptimeCheckResource struct {
+	client *client.Client
+}
+
+type uptimeCheckModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	URL            types.String `tfsdk:"url"`
+	Method         types.String `tfsdk:"method"`
+	Interval       types.Int64  `tfsdk:"interval"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	Headers        types.Map    `tfsdk:"headers"`
+	ExpectedStatus types.Int64  `tfsdk:"expected_status"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	RunAfterCreate types.Bool   `tfsdk:"run_after_create"`
+}
+
+type uptimeCheckPayload struct {
+	Name           string            `json:"name"`
+	URL            string            `json:"url"`
+	Method         string            `json:"method"`
+	Interval       int64             `json:"interval"`
+	Timeout        int64             `json:"timeout"`
+	Headers        map[string]string `json:"headers"`
+	ExpectedStatus *int64            `json:"expectedStatus"`
+	Enabled        *bool             `json:"enabled,omitempty"`
+}
+
+type uptimeCheckResponse struct {
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	URL            string            `json:"url"`
+	Method         string            `json:"method"`
+	Interval       int64             `json:"interval"`
+	Timeout        int64             `json:"timeout"`
+	Headers        map[string]string `json:"headers"`
+	ExpectedStatus *int64            `json:"expectedStatus"`
+	Enabled        bool              `json:"enabled"`
+}
+
+type uptimeCheckHistoryResponse struct {
+	Check   *uptimeCheckResponse `json:"check"`
+	Results []struct {
+		Status       string  `json:"status"`
+		StatusCode   *int64  `json:"statusCode"`
+		ErrorMessage *string `json:"errorMessage"`
+	} `json:"results"`
+}
+
+func NewUptimeCheckResource() resource.Resource {
+	return &uptimeCheckResource{}
+}
+
+func (r *uptimeCheckResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_uptime_check"
+}
+
+func (r *uptimeCheckResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"url": schema.StringAttribute{
+				Required: true,
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("GET"),
+				Validators: []validator.String{
+					oneOfValidator{values: []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}},
+				},
+			},
+			"interval": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(uptimeDefaultInterval),
+				Description: "Seconds between probes.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(uptimeDefaultTimeout),
+				Description: "Probe timeout in seconds. Must be less than interval.",
+			},
+			"headers": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Request headers sent with each probe.",
+			},
+			"expected_status": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Expected HTTP status code. Any 2xx response is up when unset.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"run_after_create": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Run the check once after creation and fail the apply if it reports down.",
+			},
+		},
+	}
+}
+
+func (r *uptimeCheckResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *uptimeCheckResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config uptimeCheckModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Interval.IsUnknown() || config.Timeout.IsUnknown() {
+		return
+	}
+
+	interval := int64(uptimeDefaultInterval)
+	if !config.Interval.IsNull() {
+		interval = config.Interval.ValueInt64()
+	}
+	timeout := int64(uptimeDefaultTimeout)
+	if !config.Timeout.IsNull() {
+		timeout = config.Timeout.ValueInt64()
+	}
+
+	if timeout >= interval {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			fmt.Sprintf("timeout (%ds) must be less than interval (%ds).", timeout, interval),
+		)
+	}
+}
+
+func (r *uptimeCheckResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan uptimeCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildUptimeCheckPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var created uptimeCheckResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/uptime/checks", payload, uuid.NewString(), &created)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	if !plan.Enabled.ValueBool() {
+		err := r.client.DoJSON(
+			ctx,
+			http.MethodPatch,
+			fmt.Sprintf("/api/uptime/checks/%s", created.ID),
+			payload,
+			uuid.NewString(),
+			nil,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", err.Error())
+		}
+	}
+
+	if !resp.Diagnostics.HasError() && plan.RunAfterCreate.ValueBool() {
+		resp.Diagnostics.Append(r.runCheck(ctx, created.ID, plan.Enabled.ValueBool())...)
+	}
+
+	state, found, diags := r.readUptimeCheckState(ctx, created.ID, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || !found {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *uptimeCheckResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state uptimeCheckModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, found, diags := r.readUptimeCheckState(ctx, state.ID.ValueString(), state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *uptimeCheckResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan uptimeCheckModel
+	var state uptimeCheckModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := buildUptimeCheckPayload(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("/api/uptime/checks/%s", state.ID.ValueString()),
+		payload,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	newState, found, diags := r.readUptimeCheckState(ctx, state.ID.ValueString(), plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Uptime check not found", "The uptime check was deleted during update.")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *uptimeCheckResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state uptimeCheckModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/uptime/checks/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *uptimeCheckResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *uptimeCheckResource) runCheck(ctx context.Context, checkID string, enabled bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !enabled {
+		diags.AddWarning(
+			"Uptime check not run",
+			"run_after_create is ignored for disabled checks.",
+		)
+		return diags
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf("/api/uptime/checks/%s/run", checkID),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return diags
+	}
+
+	var history uptimeCheckHistoryResponse
+	err = r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/uptime/checks/%s?hours=1", checkID),
+		nil,
+		"",
+		&history,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return diags
+	}
+
+	if len(history.Results) == 0 {
+		diags.AddError("Uptime check failed", "The first probe did not record a result.")
+		return diags
+	}
+
+	result := history.Results[0]
+	switch result.Status {
+	case "down":
+		detail := "The first probe reported the endpoint as down"
+		if result.StatusCode != nil {
+			detail = fmt.Sprintf("%s (HTTP %d)", detail, *result.StatusCode)
+		}
+		if result.ErrorMessage != nil {
+			detail = fmt.Sprintf("%s: %s", detail, *result.ErrorMessage)
+		}
+		diags.AddError("Uptime check failed", detail+".")
+	case "degraded":
+		diags.AddWarning("Uptime check degraded", "The first probe succeeded but responded slowly.")
+	}
+
+	return diags
+}
+
+func (r *uptimeCheckResource) readUptimeCheckState(
+	ctx context.Context,
+	checkID string,
+	prior uptimeCheckModel,
+) (uptimeCheckModel, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var history uptimeCheckHistoryResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("/api/uptime/checks/%s?hours=1", checkID),
+		nil,
+		"",
+		&history,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return uptimeCheckModel{}, false, diags
+		}
+		diags.AddError("API Error", err.Error())
+		return uptimeCheckModel{}, false, diags
+	}
+	if history.Check == nil {
+		return uptimeCheckModel{}, false, diags
+	}
+
+	state, diags := flattenUptimeCheck(ctx, *history.Check, prior)
+	return state, true, diags
+}
+
+func buildUptimeCheckPayload(ctx context.Context, plan uptimeCheckModel) (uptimeCheckPayload, diag.Diagnostics) {
+	headers, diags := expandStringMap(ctx, plan.Headers)
+	if diags.HasError() {
+		return uptimeCheckPayload{}, diags
+	}
+
+	enabled := plan.Enabled.ValueBool()
+	payload := uptimeCheckPayload{
+		Name:           plan.Name.ValueString(),
+		URL:            plan.URL.ValueString(),
+		Method:         plan.Method.ValueString(),
+		Interval:       plan.Interval.ValueInt64(),
+		Timeout:        plan.Timeout.ValueInt64(),
+		Headers:        headers,
+		ExpectedStatus: plan.ExpectedStatus.ValueInt64Pointer(),
+		Enabled:        &enabled,
+	}
+
+	return payload, diags
+}
+
+func flattenUptimeCheck(
+	ctx context.Context,
+	apiResp uptimeCheckResponse,
+	prior uptimeCheckModel,
+) (uptimeCheckModel, diag.Diagnostics) {
+	headers, diags := stringMapValue(ctx, prior.Headers, apiResp.Headers)
+	if diags.HasError() {
+		return uptimeCheckModel{}, diags
+	}
+
+	runAfterCreate := prior.RunAfterCreate
+	if runAfterCreate.IsNull() || runAfterCreate.IsUnknown() {
+		runAfterCreate = types.BoolValue(false)
+	}
+
+	state := uptimeCheckModel{
+		ID:             types.StringValue(apiResp.ID),
+		Name:           types.StringValue(apiResp.Name),
+		URL:            types.StringValue(apiResp.URL),
+		Method:         types.StringValue(apiResp.Method),
+		Interval:       types.Int64Value(apiResp.Interval),
+		Timeout:        types.Int64Value(apiResp.Timeout),
+		Headers:        headers,
+		ExpectedStatus: types.Int64PointerValue(apiResp.ExpectedStatus),
+		Enabled:        types.BoolValue(apiResp.Enabled),
+		RunAfterCreate: runAfterCreate,
+	}
+
+	return state, diags
+}
@@ -0,0 +1,96 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testUptimeCheck(t *testing.T, interval, timeout types.Int64) uptimeCheckModel {
+	return testModel(t, &uptimeCheckResource{}, func(m *uptimeCheckModel) {
+		m.Name = types.StringValue("API")
+		m.URL = types.StringValue("https://api.example.com/health")
+		m.Method = types.StringValue("GET")
+		m.Interval = interval
+		m.Timeout = timeout
+		m.Enabled = types.BoolValue(true)
+		m.RunAfterCreate = types.BoolValue(true)
+	})
+}
+
+func TestUptimeCheckValidateConfig(t *testing.T) {
+	r := &uptimeCheckResource{}
+
+	requireNoDiags(t, validateConfig(t, r, testUptimeCheck(t, types.Int64Value(60), types.Int64Value(10))))
+	requireNoDiags(t, validateConfig(t, r, testUptimeCheck(t, types.Int64Null(), types.Int64Null())))
+	requireErrorSummary(
+		t,
+		validateConfig(t, r, testUptimeCheck(t, types.Int64Value(30), types.Int64Value(30))),
+		"Invalid Timeout",
+	)
+	requireErrorSummary(
+		t,
+		validateConfig(t, r, testUptimeCheck(t, types.Int64Null(), types.Int64Value(uptimeDefaultInterval))),
+		"Invalid Timeout",
+	)
+}
+
+func testUptimeServer(t *testing.T, status string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/uptime/checks":
+			_ = json.NewEncoder(w).Encode(uptimeCheckResponse{ID: "check_1"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/uptime/checks/check_1/run":
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/uptime/checks/check_1":
+			statusCode := int64(503)
+			history := uptimeCheckHistoryResponse{Check: &uptimeCheckResponse{
+				ID:       "check_1",
+				Name:     "API",
+				URL:      "https://api.example.com/health",
+				Method:   "GET",
+				Interval: 60,
+				Timeout:  10,
+				Enabled:  true,
+			}}
+			history.Results = append(history.Results, struct {
+				Status       string  `json:"status"`
+				StatusCode   *int64  `json:"statusCode"`
+				ErrorMessage *string `json:"errorMessage"`
+			}{Status: status, StatusCode: &statusCode})
+			_ = json.NewEncoder(w).Encode(history)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestUptimeCheckCreateSavesStateWhenFirstProbeFails(t *testing.T) {
+	r := &uptimeCheckResource{client: testClient(t, testUptimeServer(t, "down"))}
+
+	state, diags := createResource(t, r, testUptimeCheck(t, types.Int64Value(60), types.Int64Value(10)))
+	requireErrorSummary(t, diags, "Uptime check failed")
+	if !strings.Contains(diags.Errors()[0].Detail(), "HTTP 503") {
+		t.Fatalf("expected the status code in the error, got %q", diags.Errors()[0].Detail())
+	}
+
+	var got uptimeCheckModel
+	requireNoDiags(t, state.Get(context.Background(), &got))
+	if got.ID.ValueString() != "check_1" {
+		t.Fatalf("unexpected state: %+v", got)
+	}
+}
+
+func TestUptimeCheckCreateWarnsWhenFirstProbeDegraded(t *testing.T) {
+	r := &uptimeCheckResource{client: testClient(t, testUptimeServer(t, "degraded"))}
+
+	_, diags := createResource(t, r, testUptimeCheck(t, types.Int64Value(60), types.Int64Value(10)))
+	requireNoDiags(t, diags)
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Uptime check degraded" {
+		t.Fatalf("expected a degraded warning, got %v", diags)
+	}
+}
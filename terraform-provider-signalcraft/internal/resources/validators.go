@@ -0,0 +1,282 @@
+package resources
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be an RFC3339 timestamp"
+}
+
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc3339Validator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Expected an RFC3339 timestamp such as 2026-01-02T15:04:05Z, got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as 30s or 10m"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Expected a positive duration such as 30s or 10m, got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+type float64RangeValidator struct {
+	min float64
+	max float64
+}
+
+func (v float64RangeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %g and %g", v.min, v.max)
+}
+
+func (v float64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v float64RangeValidator) ValidateFloat64(
+	_ context.Context,
+	req validator.Float64Request,
+	resp *validator.Float64Response,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueFloat64()
+	if value < v.min || value > v.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Value Out of Range",
+			fmt.Sprintf("Expected a value between %g and %g, got %g.", v.min, v.max, value),
+		)
+	}
+}
+
+type oneOfValidator struct {
+	values []string
+}
+
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", strings.Join(v.values, ", "))
+}
+
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	v.check(req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+func (v oneOfValidator) ValidateList(
+	_ context.Context,
+	req validator.ListRequest,
+	resp *validator.ListResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for index, element := range req.ConfigValue.Elements() {
+		v.check(req.Path.AtListIndex(index), element, &resp.Diagnostics)
+	}
+}
+
+func (v oneOfValidator) ValidateSet(
+	_ context.Context,
+	req validator.SetRequest,
+	resp *validator.SetResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, element := range req.ConfigValue.Elements() {
+		v.check(req.Path.AtSetValue(element), element, &resp.Diagnostics)
+	}
+}
+
+func (v oneOfValidator) ValidateMap(
+	_ context.Context,
+	req validator.MapRequest,
+	resp *validator.MapResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for key, element := range req.ConfigValue.Elements() {
+		v.check(req.Path.AtMapKey(key), element, &resp.Diagnostics)
+	}
+}
+
+func (v oneOfValidator) check(attrPath path.Path, value attr.Value, diags *diag.Diagnostics) {
+	str, ok := value.(types.String)
+	if !ok || str.IsNull() || str.IsUnknown() {
+		return
+	}
+
+	for _, allowed := range v.values {
+		if str.ValueString() == allowed {
+			return
+		}
+	}
+
+	diags.AddAttributeError(
+		attrPath,
+		"Invalid Value",
+		fmt.Sprintf("Expected one of %s, got %q.", strings.Join(v.values, ", "), str.ValueString()),
+	)
+}
+
+type patternValidator struct {
+	pattern *regexp.Regexp
+	message string
+}
+
+func (v patternValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v patternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v patternValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !v.pattern.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("%s, got %q.", v.message, req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+type pemCertificateValidator struct {
+	warnWithin time.Duration
+}
+
+func (v pemCertificateValidator) Description(_ context.Context) string {
+	return "value must be a valid, unexpired PEM-encoded X.509 certificate"
+}
+
+func (v pemCertificateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v pemCertificateValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	block, _ := pem.Decode([]byte(strings.TrimSpace(req.ConfigValue.ValueString())))
+	if block == nil || block.Type != "CERTIFICATE" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Certificate",
+			"Expected a PEM-encoded certificate starting with -----BEGIN CERTIFICATE-----.",
+		)
+		return
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Certificate",
+			fmt.Sprintf("The certificate could not be parsed: %s.", err),
+		)
+		return
+	}
+
+	now := time.Now()
+	switch {
+	case now.After(cert.NotAfter):
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Certificate Expired",
+			fmt.Sprintf("The certificate for %q expired at %s.", cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339)),
+		)
+	case now.Before(cert.NotBefore):
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Certificate Not Yet Valid",
+			fmt.Sprintf("The certificate for %q is not valid before %s.", cert.Subject.CommonName, cert.NotBefore.Format(time.RFC3339)),
+		)
+	case now.Add(v.warnWithin).After(cert.NotAfter):
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Certificate Expiring Soon",
+			fmt.Sprintf("The certificate for %q expires at %s.", cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339)),
+		)
+	}
+}
@@ -0,0 +1,135 @@
+package resources
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func validateString(v validator.String, value types.String) validator.StringResponse {
+	var resp validator.StringResponse
+	v.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("test"),
+		ConfigValue: value,
+	}, &resp)
+	return resp
+}
+
+func TestRFC3339Validator(t *testing.T) {
+	v := rfc3339Validator{}
+
+	requireNoDiags(t, validateString(v, types.StringValue("2026-01-02T15:04:05Z")).Diagnostics)
+	requireNoDiags(t, validateString(v, types.StringValue("2026-01-02T15:04:05+02:00")).Diagnostics)
+	requireNoDiags(t, validateString(v, types.StringNull()).Diagnostics)
+	requireErrorSummary(t, validateString(v, types.StringValue("2026-01-02")).Diagnostics, "Invalid Timestamp")
+}
+
+func TestPatternValidator(t *testing.T) {
+	v := patternValidator{pattern: statusPageSlugPattern, message: "slug must be lowercase"}
+
+	requireNoDiags(t, validateString(v, types.StringValue("acme-status")).Diagnostics)
+	requireNoDiags(t, validateString(v, types.StringNull()).Diagnostics)
+	requireErrorSummary(t, validateString(v, types.StringValue("Acme")).Diagnostics, "Invalid Value")
+	requireErrorSummary(t, validateString(v, types.StringValue("acme--status")).Diagnostics, "Invalid Value")
+}
+
+func TestFloat64RangeValidator(t *testing.T) {
+	v := float64RangeValidator{min: 0, max: 1}
+	validate := func(value types.Float64) validator.Float64Response {
+		var resp validator.Float64Response
+		v.ValidateFloat64(context.Background(), validator.Float64Request{
+			Path:        path.Root("test"),
+			ConfigValue: value,
+		}, &resp)
+		return resp
+	}
+
+	requireNoDiags(t, validate(types.Float64Value(0)).Diagnostics)
+	requireNoDiags(t, validate(types.Float64Value(1)).Diagnostics)
+	requireNoDiags(t, validate(types.Float64Null()).Diagnostics)
+	requireNoDiags(t, validate(types.Float64Unknown()).Diagnostics)
+	requireErrorSummary(t, validate(types.Float64Value(1.5)).Diagnostics, "Value Out of Range")
+	requireErrorSummary(t, validate(types.Float64Value(-0.1)).Diagnostics, "Value Out of Range")
+}
+
+func TestOneOfValidator(t *testing.T) {
+	v := oneOfValidator{values: []string{"SLACK", "EMAIL"}}
+
+	requireNoDiags(t, validateString(v, types.StringValue("SLACK")).Diagnostics)
+	requireNoDiags(t, validateString(v, types.StringNull()).Diagnostics)
+	requireNoDiags(t, validateString(v, types.StringUnknown()).Diagnostics)
+	requireErrorSummary(t, validateString(v, types.StringValue("slack")).Diagnostics, "Invalid Value")
+
+	set := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("EMAIL"),
+		types.StringValue("FAX"),
+	})
+	var resp validator.SetResponse
+	v.ValidateSet(context.Background(), validator.SetRequest{
+		Path:        path.Root("channels"),
+		ConfigValue: set,
+	}, &resp)
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected one invalid element, got %v", resp.Diagnostics)
+	}
+}
+
+func testCertificate(t *testing.T, notBefore, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestPemCertificateValidator(t *testing.T) {
+	v := pemCertificateValidator{warnWithin: 30 * 24 * time.Hour}
+	now := time.Now()
+
+	resp := validateString(v, types.StringValue(testCertificate(t, now.Add(-time.Hour), now.Add(365*24*time.Hour))))
+	requireNoDiags(t, resp.Diagnostics)
+	if resp.Diagnostics.WarningsCount() != 0 {
+		t.Fatalf("unexpected warnings: %v", resp.Diagnostics)
+	}
+
+	resp = validateString(v, types.StringValue(testCertificate(t, now.Add(-time.Hour), now.Add(24*time.Hour))))
+	requireNoDiags(t, resp.Diagnostics)
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected an expiry warning, got %v", resp.Diagnostics)
+	}
+
+	requireErrorSummary(
+		t,
+		validateString(v, types.StringValue(testCertificate(t, now.Add(-48*time.Hour), now.Add(-24*time.Hour)))).Diagnostics,
+		"Certificate Expired",
+	)
+	requireErrorSummary(
+		t,
+		validateString(v, types.StringValue(testCertificate(t, now.Add(24*time.Hour), now.Add(48*time.Hour)))).Diagnostics,
+		"Certificate Not Yet Valid",
+	)
+	requireErrorSummary(t, validateString(v, types.StringValue("not a certificate")).Diagnostics, "Invalid Certificate")
+}
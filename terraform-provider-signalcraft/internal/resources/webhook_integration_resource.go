@@ -0,0 +1,424 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalcraft/terraform-provider-signalcraft/internal/client"
+)
+
+var webhookIntegrationTypes = []string{
+	"AWS_CLOUDWATCH",
+	"AZURE_MONITOR",
+	"GCP_MONITORING",
+	"GENERIC_WEBHOOK",
+	"GRAFANA",
+	"PROMETHEUS",
+}
+
+var webhookFieldMappingKeys = []string{
+	"title",
+	"message",
+	"severity",
+	"sourceEventId",
+	"description",
+	"environment",
+	"project",
+	"link",
+}
+
+var alertSeverities = []string{"INFO", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+type webhookIntegrationResource struct {
+	client *client.Client
+}
+
+type webhookIntegrationModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	FieldMappings types.Map    `tfsdk:"field_mappings"`
+	SeverityMap   types.Map    `tfsdk:"severity_map"`
+	WebhookURL    types.String `tfsdk:"webhook_url"`
+	WebhookToken  types.String `tfsdk:"webhook_token"`
+}
+
+type webhookIntegrationCreatePayload struct {
+	Name          string            `json:"name"`
+	Type          string            `json:"type"`
+	FieldMappings map[string]string `json:"fieldMappings"`
+	SeverityMap   map[string]string `json:"severityMap"`
+}
+
+type webhookIntegrationUpdatePayload struct {
+	Name          string            `json:"name"`
+	Enabled       bool              `json:"enabled"`
+	FieldMappings map[string]string `json:"fieldMappings"`
+	SeverityMap   map[string]string `json:"severityMap"`
+}
+
+type webhookIntegrationResponse struct {
+	ID              string            `json:"id"`
+	Name            string            `json:"name"`
+	IntegrationType string            `json:"integrationType"`
+	Enabled         bool              `json:"enabled"`
+	FieldMappings   map[string]string `json:"fieldMappings"`
+	SeverityMap     map[string]string `json:"severityMap"`
+	WebhookURL      string            `json:"webhookUrl"`
+	WebhookToken    string            `json:"webhookToken"`
+}
+
+func NewWebhookIntegrationResource() resource.Resource {
+	return &webhookIntegrationResource{}
+}
+
+func (r *webhookIntegrationResource) Metadata(
+	_ context.Context,
+	_ resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = "signalcraft_webhook_integration"
+}
+
+func (r *webhookIntegrationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					oneOfValidator{values: webhookIntegrationTypes},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"field_mappings": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "JSONPath expressions keyed by alert field (title, message, severity, sourceEventId, description, environment, project, link). Required for GENERIC_WEBHOOK.",
+			},
+			"severity_map": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					oneOfValidator{values: alertSeverities},
+				},
+				Description: "Maps lowercase source severities to SignalCraft severities.",
+			},
+			"webhook_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Generated URL to configure in the sending system. It embeds the webhook token.",
+			},
+			"webhook_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *webhookIntegrationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client.Client)
+}
+
+func (r *webhookIntegrationResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config webhookIntegrationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.ValueString() == "GENERIC_WEBHOOK" && config.FieldMappings.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("field_mappings"),
+			"Missing Field Mappings",
+			"GENERIC_WEBHOOK integrations need field_mappings to extract alerts from the payload.",
+		)
+	}
+
+	if !config.FieldMappings.IsNull() && !config.FieldMappings.IsUnknown() {
+		for key := range config.FieldMappings.Elements() {
+			if !containsString(webhookFieldMappingKeys, key) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("field_mappings").AtMapKey(key),
+					"Invalid Field Mapping",
+					fmt.Sprintf("Expected one of %s, got %q.", strings.Join(webhookFieldMappingKeys, ", "), key),
+				)
+			}
+		}
+	}
+
+	if !config.SeverityMap.IsNull() && !config.SeverityMap.IsUnknown() {
+		for key := range config.SeverityMap.Elements() {
+			if key != strings.ToLower(key) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("severity_map").AtMapKey(key),
+					"Invalid Severity Key",
+					fmt.Sprintf("Source severities are matched in lowercase, use %q.", strings.ToLower(key)),
+				)
+			}
+		}
+	}
+}
+
+func (r *webhookIntegrationResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan webhookIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fieldMappings, diags := expandStringMap(ctx, plan.FieldMappings)
+	resp.Diagnostics.Append(diags...)
+	severityMap, diags := expandStringMap(ctx, plan.SeverityMap)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := webhookIntegrationCreatePayload{
+		Name:          plan.Name.ValueString(),
+		Type:          plan.Type.ValueString(),
+		FieldMappings: fieldMappings,
+		SeverityMap:   severityMap,
+	}
+
+	var apiResp webhookIntegrationResponse
+	err := r.client.DoJSON(ctx, http.MethodPost, "/api/integrations/webhooks", payload, uuid.NewString(), &apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	if !plan.Enabled.ValueBool() {
+		updated, diags := r.updateWebhook(ctx, apiResp.ID, plan)
+		resp.Diagnostics.Append(diags...)
+		if !diags.HasError() {
+			apiResp = updated
+		}
+	}
+
+	state, diags := flattenWebhookIntegration(ctx, apiResp, plan)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *webhookIntegrationResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state webhookIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var webhooks []webhookIntegrationResponse
+	err := r.client.DoJSON(ctx, http.MethodGet, "/api/integrations/webhooks", nil, "", &webhooks)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+
+	for _, webhook := range webhooks {
+		if webhook.ID != state.ID.ValueString() {
+			continue
+		}
+
+		newState, diags := flattenWebhookIntegration(ctx, webhook, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *webhookIntegrationResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan webhookIntegrationModel
+	var state webhookIntegrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, diags := r.updateWebhook(ctx, state.ID.ValueString(), plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := flattenWebhookIntegration(ctx, apiResp, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+func (r *webhookIntegrationResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state webhookIntegrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/api/integrations/webhooks/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
+}
+
+func (r *webhookIntegrationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *webhookIntegrationResource) updateWebhook(
+	ctx context.Context,
+	webhookID string,
+	plan webhookIntegrationModel,
+) (webhookIntegrationResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fieldMappings, mapDiags := expandStringMap(ctx, plan.FieldMappings)
+	diags.Append(mapDiags...)
+	severityMap, mapDiags := expandStringMap(ctx, plan.SeverityMap)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return webhookIntegrationResponse{}, diags
+	}
+
+	payload := webhookIntegrationUpdatePayload{
+		Name:          plan.Name.ValueString(),
+		Enabled:       plan.Enabled.ValueBool(),
+		FieldMappings: fieldMappings,
+		SeverityMap:   severityMap,
+	}
+
+	var apiResp webhookIntegrationResponse
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodPatch,
+		fmt.Sprintf("/api/integrations/webhooks/%s", webhookID),
+		payload,
+		uuid.NewString(),
+		&apiResp,
+	)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return webhookIntegrationResponse{}, diags
+	}
+
+	return apiResp, diags
+}
+
+func flattenWebhookIntegration(
+	ctx context.Context,
+	apiResp webhookIntegrationResponse,
+	prior webhookIntegrationModel,
+) (webhookIntegrationModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fieldMappings, mapDiags := stringMapValue(ctx, prior.FieldMappings, apiResp.FieldMappings)
+	diags.Append(mapDiags...)
+	severityMap, mapDiags := stringMapValue(ctx, prior.SeverityMap, apiResp.SeverityMap)
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return webhookIntegrationModel{}, diags
+	}
+
+	return webhookIntegrationModel{
+		ID:            types.StringValue(apiResp.ID),
+		Name:          types.StringValue(apiResp.Name),
+		Type:          types.StringValue(apiResp.IntegrationType),
+		Enabled:       types.BoolValue(apiResp.Enabled),
+		FieldMappings: fieldMappings,
+		SeverityMap:   severityMap,
+		WebhookURL:    types.StringValue(apiResp.WebhookURL),
+		WebhookToken:  types.StringValue(apiResp.WebhookToken),
+	}, diags
+}
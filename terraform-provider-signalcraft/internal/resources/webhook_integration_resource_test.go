@@ -0,0 +1,65 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testWebhookIntegration(t *testing.T, integrationType string, fieldMappings, severityMap types.Map) webhookIntegrationModel {
+	return testModel(t, &webhookIntegrationResource{}, func(m *webhookIntegrationModel) {
+		m.Name = types.StringValue("Alertmanager")
+		m.Type = types.StringValue(integrationType)
+		m.Enabled = types.BoolValue(true)
+		m.FieldMappings = fieldMappings
+		m.SeverityMap = severityMap
+	})
+}
+
+func testStringMap(values map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestWebhookIntegrationValidateConfig(t *testing.T) {
+	r := &webhookIntegrationResource{}
+	noMap := types.MapNull(types.StringType)
+
+	requireNoDiags(t, validateConfig(t, r, testWebhookIntegration(t, "PROMETHEUS", noMap, noMap)))
+	requireErrorSummary(
+		t,
+		validateConfig(t, r, testWebhookIntegration(t, "GENERIC_WEBHOOK", noMap, noMap)),
+		"Missing Field Mappings",
+	)
+	requireErrorSummary(
+		t,
+		validateConfig(t, r, testWebhookIntegration(t, "GENERIC_WEBHOOK", testStringMap(map[string]string{"headline": "$.title"}), noMap)),
+		"Invalid Field Mapping",
+	)
+	requireErrorSummary(
+		t,
+		validateConfig(t, r, testWebhookIntegration(t, "PROMETHEUS", noMap, testStringMap(map[string]string{"Critical": "CRITICAL"}))),
+		"Invalid Severity Key",
+	)
+}
+
+func TestStringMapValueKeepsUnsetMapNull(t *testing.T) {
+	ctx := context.Background()
+
+	value, diags := stringMapValue(ctx, types.MapNull(types.StringType), map[string]string{})
+	requireNoDiags(t, diags)
+	if !value.IsNull() {
+		t.Fatalf("expected null map, got %s", value)
+	}
+
+	value, diags = stringMapValue(ctx, testStringMap(map[string]string{"a": "b"}), map[string]string{})
+	requireNoDiags(t, diags)
+	if value.IsNull() || len(value.Elements()) != 0 {
+		t.Fatalf("expected empty map, got %s", value)
+	}
+}
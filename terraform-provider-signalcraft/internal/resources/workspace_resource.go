@@ -2,12 +2,17 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,17 +24,43 @@ type workspaceResource struct {
 }
 
 type workspaceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID                        types.String  `tfsdk:"id"`
+	Name                      types.String  `tfsdk:"name"`
+	AdoptCurrent              types.Bool    `tfsdk:"adopt_current"`
+	DeletionProtection        types.Bool    `tfsdk:"deletion_protection"`
+	BootstrapAPIKey           types.String  `tfsdk:"bootstrap_api_key"`
+	HighImpactUserThreshold   types.Int64   `tfsdk:"high_impact_user_threshold"`
+	MediumImpactUserThreshold types.Int64   `tfsdk:"medium_impact_user_threshold"`
+	HighVelocityThreshold     types.Float64 `tfsdk:"high_velocity_threshold"`
+}
+
+type workspaceModelV0 struct {
+	ID                        types.String  `tfsdk:"id"`
+	Name                      types.String  `tfsdk:"name"`
+	HighImpactUserThreshold   types.Int64   `tfsdk:"high_impact_user_threshold"`
+	MediumImpactUserThreshold types.Int64   `tfsdk:"medium_impact_user_threshold"`
+	HighVelocityThreshold     types.Float64 `tfsdk:"high_velocity_threshold"`
 }
 
 type workspaceResponse struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID                        string                    `json:"id"`
+	Name                      string                    `json:"name"`
+	HighImpactUserThreshold   int64                     `json:"highImpactUserThreshold"`
+	MediumImpactUserThreshold int64                     `json:"mediumImpactUserThreshold"`
+	HighVelocityThreshold     float64                   `json:"highVelocityThreshold"`
+	BootstrapAPIKey           *workspaceBootstrapAPIKey `json:"bootstrapApiKey"`
+}
+
+type workspaceBootstrapAPIKey struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
 }
 
 type workspaceUpdatePayload struct {
-	Name string `json:"name"`
+	Name                      string   `json:"name"`
+	HighImpactUserThreshold   *int64   `json:"highImpactUserThreshold,omitempty"`
+	MediumImpactUserThreshold *int64   `json:"mediumImpactUserThreshold,omitempty"`
+	HighVelocityThreshold     *float64 `json:"highVelocityThreshold,omitempty"`
 }
 
 func NewWorkspaceResource() resource.Resource {
@@ -50,6 +81,8 @@ func (r *workspaceResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		Version:     1,
+		Description: "SignalCraft workspace. Creates a new workspace owned by the API key's workspace, or with adopt_current manages the API key's own workspace.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -60,6 +93,88 @@ func (r *workspaceResource) Schema(
 			"name": schema.StringAttribute{
 				Required: true,
 			},
+			"adopt_current": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Manage the workspace the provider's API key belongs to instead of creating one. Destroying it only removes it from state. Set it on one resource per provider configuration at most.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether destroying a created workspace fails. Set it to false and apply before destroying the workspace.",
+			},
+			"bootstrap_api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "API key of the created workspace, for configuring another signalcraft provider. It is only returned on creation and is null for adopted or imported workspaces.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"high_impact_user_threshold": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Affected users at which an alert is high impact. Must be greater than medium_impact_user_threshold.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"medium_impact_user_threshold": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Affected users at which an alert is medium impact.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"high_velocity_threshold": schema.Float64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Events per hour at which an alert is high velocity.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *workspaceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                           schema.StringAttribute{Computed: true},
+					"name":                         schema.StringAttribute{Required: true},
+					"high_impact_user_threshold":   schema.Int64Attribute{Optional: true, Computed: true},
+					"medium_impact_user_threshold": schema.Int64Attribute{Optional: true, Computed: true},
+					"high_velocity_threshold":      schema.Float64Attribute{Optional: true, Computed: true},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior workspaceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state := workspaceModel{
+					ID:                        prior.ID,
+					Name:                      prior.Name,
+					AdoptCurrent:              types.BoolValue(true),
+					DeletionProtection:        types.BoolValue(true),
+					BootstrapAPIKey:           types.StringNull(),
+					HighImpactUserThreshold:   prior.HighImpactUserThreshold,
+					MediumImpactUserThreshold: prior.MediumImpactUserThreshold,
+					HighVelocityThreshold:     prior.HighVelocityThreshold,
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			},
 		},
 	}
 }
@@ -75,6 +190,79 @@ func (r *workspaceResource) Configure(
 	r.client = req.ProviderData.(*client.Client)
 }
 
+func (r *workspaceResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config workspaceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	thresholds := []struct {
+		name  string
+		value types.Int64
+	}{
+		{"high_impact_user_threshold", config.HighImpactUserThreshold},
+		{"medium_impact_user_threshold", config.MediumImpactUserThreshold},
+	}
+	for _, threshold := range thresholds {
+		name, value := threshold.name, threshold.value
+		if !value.IsNull() && !value.IsUnknown() && value.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Threshold",
+				fmt.Sprintf("%s must be at least 1, got %d.", name, value.ValueInt64()),
+			)
+		}
+	}
+
+	velocity := config.HighVelocityThreshold
+	if !velocity.IsNull() && !velocity.IsUnknown() && velocity.ValueFloat64() <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("high_velocity_threshold"),
+			"Invalid Threshold",
+			fmt.Sprintf("high_velocity_threshold must be greater than 0, got %g.", velocity.ValueFloat64()),
+		)
+	}
+}
+
+func (r *workspaceResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan workspaceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	high := plan.HighImpactUserThreshold
+	medium := plan.MediumImpactUserThreshold
+	if high.IsNull() || high.IsUnknown() || medium.IsNull() || medium.IsUnknown() {
+		return
+	}
+
+	if medium.ValueInt64() >= high.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("medium_impact_user_threshold"),
+			"Invalid Threshold",
+			fmt.Sprintf(
+				"medium_impact_user_threshold (%d) must be less than high_impact_user_threshold (%d).",
+				medium.ValueInt64(),
+				high.ValueInt64(),
+			),
+		)
+	}
+}
+
 func (r *workspaceResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -86,24 +274,39 @@ func (r *workspaceResource) Create(
 		return
 	}
 
-	payload := workspaceUpdatePayload{Name: plan.Name.ValueString()}
-	err := r.client.DoJSON(ctx, http.MethodPut, "/settings/workspace", payload, uuid.NewString(), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error", err.Error())
+	payload := buildWorkspacePayload(plan)
+	if plan.AdoptCurrent.ValueBool() {
+		err := r.client.DoJSON(ctx, http.MethodPut, "/settings/workspace", payload, uuid.NewString(), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
+
+		var apiResp workspaceResponse
+		err = r.client.DoJSON(ctx, http.MethodGet, "/settings/workspace", nil, "", &apiResp)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
+
+		plan.BootstrapAPIKey = types.StringNull()
+		state := flattenWorkspace(apiResp, plan)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 		return
 	}
 
 	var apiResp workspaceResponse
-	err = r.client.DoJSON(ctx, http.MethodGet, "/settings/workspace", nil, "", &apiResp)
+	err := r.client.DoJSON(ctx, http.MethodPost, "/workspaces", payload, uuid.NewString(), &apiResp)
 	if err != nil {
 		resp.Diagnostics.AddError("API Error", err.Error())
 		return
 	}
 
-	state := workspaceModel{
-		ID:   types.StringValue(apiResp.ID),
-		Name: types.StringValue(apiResp.Name),
+	plan.BootstrapAPIKey = types.StringNull()
+	if apiResp.BootstrapAPIKey != nil {
+		plan.BootstrapAPIKey = types.StringValue(apiResp.BootstrapAPIKey.Key)
 	}
+	state := flattenWorkspace(apiResp, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -118,8 +321,13 @@ func (r *workspaceResource) Read(
 		return
 	}
 
+	apiPath := fmt.Sprintf("/workspaces/%s", state.ID.ValueString())
+	if state.AdoptCurrent.ValueBool() {
+		apiPath = "/settings/workspace"
+	}
+
 	var apiResp workspaceResponse
-	err := r.client.DoJSON(ctx, http.MethodGet, "/settings/workspace", nil, "", &apiResp)
+	err := r.client.DoJSON(ctx, http.MethodGet, apiPath, nil, "", &apiResp)
 	if err != nil {
 		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
@@ -129,10 +337,21 @@ func (r *workspaceResource) Read(
 		return
 	}
 
-	newState := workspaceModel{
-		ID:   types.StringValue(apiResp.ID),
-		Name: types.StringValue(apiResp.Name),
+	if state.AdoptCurrent.ValueBool() && apiResp.ID != state.ID.ValueString() {
+		resp.Diagnostics.AddError(
+			"Workspace Mismatch",
+			fmt.Sprintf(
+				"The provider's API key belongs to workspace %s, but this resource adopted workspace %s. "+
+					"Use an API key of workspace %s, or remove the resource from state and adopt the new workspace.",
+				apiResp.ID,
+				state.ID.ValueString(),
+				state.ID.ValueString(),
+			),
+		)
+		return
 	}
+
+	newState := flattenWorkspace(apiResp, state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
@@ -142,38 +361,87 @@ func (r *workspaceResource) Update(
 	resp *resource.UpdateResponse,
 ) {
 	var plan workspaceModel
+	var state workspaceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	payload := workspaceUpdatePayload{Name: plan.Name.ValueString()}
-	err := r.client.DoJSON(ctx, http.MethodPut, "/settings/workspace", payload, uuid.NewString(), nil)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error", err.Error())
-		return
-	}
-
+	payload := buildWorkspacePayload(plan)
 	var apiResp workspaceResponse
-	err = r.client.DoJSON(ctx, http.MethodGet, "/settings/workspace", nil, "", &apiResp)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error", err.Error())
-		return
-	}
+	if plan.AdoptCurrent.ValueBool() {
+		err := r.client.DoJSON(ctx, http.MethodPut, "/settings/workspace", payload, uuid.NewString(), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
 
-	newState := workspaceModel{
-		ID:   types.StringValue(apiResp.ID),
-		Name: types.StringValue(apiResp.Name),
+		err = r.client.DoJSON(ctx, http.MethodGet, "/settings/workspace", nil, "", &apiResp)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
+	} else {
+		err := r.client.DoJSON(
+			ctx,
+			http.MethodPatch,
+			fmt.Sprintf("/workspaces/%s", state.ID.ValueString()),
+			payload,
+			uuid.NewString(),
+			&apiResp,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
 	}
+
+	newState := flattenWorkspace(apiResp, plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
 func (r *workspaceResource) Delete(
-	_ context.Context,
-	_ resource.DeleteRequest,
-	_ *resource.DeleteResponse,
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
 ) {
-	return
+	var state workspaceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.AdoptCurrent.ValueBool() {
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protected",
+			fmt.Sprintf(
+				"Workspace %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.",
+				state.ID.ValueString(),
+			),
+		)
+		return
+	}
+
+	err := r.client.DoJSON(
+		ctx,
+		http.MethodDelete,
+		fmt.Sprintf("/workspaces/%s", state.ID.ValueString()),
+		nil,
+		uuid.NewString(),
+		nil,
+	)
+	if err != nil {
+		if httpErr, ok := err.(*client.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", err.Error())
+		return
+	}
 }
 
 func (r *workspaceResource) ImportState(
@@ -181,5 +449,46 @@ func (r *workspaceResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := req.ID
+	adopt := req.ID == "current"
+	if adopt {
+		var apiResp workspaceResponse
+		err := r.client.DoJSON(ctx, http.MethodGet, "/settings/workspace", nil, "", &apiResp)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", err.Error())
+			return
+		}
+		id = apiResp.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_current"), adopt)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), true)...)
+}
+
+func buildWorkspacePayload(plan workspaceModel) workspaceUpdatePayload {
+	payload := workspaceUpdatePayload{Name: plan.Name.ValueString()}
+	if !plan.HighImpactUserThreshold.IsUnknown() {
+		payload.HighImpactUserThreshold = plan.HighImpactUserThreshold.ValueInt64Pointer()
+	}
+	if !plan.MediumImpactUserThreshold.IsUnknown() {
+		payload.MediumImpactUserThreshold = plan.MediumImpactUserThreshold.ValueInt64Pointer()
+	}
+	if !plan.HighVelocityThreshold.IsUnknown() {
+		payload.HighVelocityThreshold = plan.HighVelocityThreshold.ValueFloat64Pointer()
+	}
+	return payload
+}
+
+func flattenWorkspace(apiResp workspaceResponse, prior workspaceModel) workspaceModel {
+	return workspaceModel{
+		ID:                        types.StringValue(apiResp.ID),
+		Name:                      types.StringValue(apiResp.Name),
+		AdoptCurrent:              prior.AdoptCurrent,
+		DeletionProtection:        prior.DeletionProtection,
+		BootstrapAPIKey:           prior.BootstrapAPIKey,
+		HighImpactUserThreshold:   types.Int64Value(apiResp.HighImpactUserThreshold),
+		MediumImpactUserThreshold: types.Int64Value(apiResp.MediumImpactUserThreshold),
+		HighVelocityThreshold:     types.Float64Value(apiResp.HighVelocityThreshold),
+	}
 }
@@ -0,0 +1,117 @@
+package resources
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testWorkspace(t *testing.T, adopt bool, high, medium int64) workspaceModel {
+	return testModel(t, &workspaceResource{}, func(m *workspaceModel) {
+		m.ID = types.StringValue("ws_1")
+		m.Name = types.StringValue("SignalCraft Ops")
+		m.AdoptCurrent = types.BoolValue(adopt)
+		m.DeletionProtection = types.BoolValue(true)
+		m.BootstrapAPIKey = types.StringNull()
+		m.HighImpactUserThreshold = types.Int64Value(high)
+		m.MediumImpactUserThreshold = types.Int64Value(medium)
+		m.HighVelocityThreshold = types.Float64Value(25)
+	})
+}
+
+func TestWorkspaceUpgradeStateAdoptsCurrentWorkspace(t *testing.T) {
+	ctx := context.Background()
+	r := &workspaceResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+
+	prior := workspaceModelV0{
+		ID:                        types.StringValue("ws_1"),
+		Name:                      types.StringValue("SignalCraft Ops"),
+		HighImpactUserThreshold:   types.Int64Value(100),
+		MediumImpactUserThreshold: types.Int64Value(20),
+		HighVelocityThreshold:     types.Float64Value(25),
+	}
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: testValue(t, *upgrader.PriorSchema, prior)},
+	}
+	resp := resource.UpgradeStateResponse{State: testState(t, r, nil)}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	requireNoDiags(t, resp.Diagnostics)
+
+	var state workspaceModel
+	requireNoDiags(t, resp.State.Get(ctx, &state))
+	if !state.AdoptCurrent.ValueBool() || !state.DeletionProtection.ValueBool() || !state.BootstrapAPIKey.IsNull() ||
+		state.ID.ValueString() != "ws_1" || state.HighImpactUserThreshold.ValueInt64() != 100 {
+		t.Fatalf("unexpected state: %+v", state)
+	}
+}
+
+func TestWorkspaceModifyPlanComparesThresholds(t *testing.T) {
+	r := &workspaceResource{}
+
+	requireNoDiags(t, modifyPlan(t, r, nil, testWorkspace(t, false, 100, 20)).Diagnostics)
+	requireErrorSummary(t, modifyPlan(t, r, nil, testWorkspace(t, false, 20, 20)).Diagnostics, "Invalid Threshold")
+
+	unknown := testWorkspace(t, false, 100, 200)
+	unknown.HighImpactUserThreshold = types.Int64Unknown()
+	requireNoDiags(t, modifyPlan(t, r, nil, unknown).Diagnostics)
+}
+
+func TestWorkspaceModifyPlanAllowsAdoptingOnReplace(t *testing.T) {
+	r := &workspaceResource{}
+	created := testWorkspace(t, false, 100, 20)
+	adopted := testWorkspace(t, true, 100, 20)
+
+	requireNoDiags(t, modifyPlan(t, r, created, adopted).Diagnostics)
+	requireNoDiags(t, modifyPlan(t, r, nil, adopted).Diagnostics)
+}
+
+func TestWorkspaceValidateConfig(t *testing.T) {
+	r := &workspaceResource{}
+
+	requireNoDiags(t, validateConfig(t, r, testWorkspace(t, false, 100, 20)))
+	requireErrorSummary(t, validateConfig(t, r, testWorkspace(t, false, 100, 0)), "Invalid Threshold")
+
+	slow := testWorkspace(t, false, 100, 20)
+	slow.HighVelocityThreshold = types.Float64Value(0)
+	requireErrorSummary(t, validateConfig(t, r, slow), "Invalid Threshold")
+}
+
+func TestWorkspaceDelete(t *testing.T) {
+	var deleted []string
+	r := &workspaceResource{client: testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		deleted = append(deleted, r.URL.Path)
+	})}
+
+	requireNoDiags(t, deleteResource(t, r, testWorkspace(t, true, 100, 20)))
+	requireErrorSummary(t, deleteResource(t, r, testWorkspace(t, false, 100, 20)), "Deletion Protected")
+
+	unprotected := testWorkspace(t, false, 100, 20)
+	unprotected.DeletionProtection = types.BoolValue(false)
+	requireNoDiags(t, deleteResource(t, r, unprotected))
+
+	if len(deleted) != 1 || deleted[0] != "/workspaces/ws_1" {
+		t.Fatalf("expected only the unprotected created workspace to be deleted, got %v", deleted)
+	}
+}
+
+func TestBuildWorkspacePayloadOmitsUnknownThresholds(t *testing.T) {
+	plan := testWorkspace(t, false, 100, 20)
+	plan.MediumImpactUserThreshold = types.Int64Unknown()
+	plan.HighVelocityThreshold = types.Float64Unknown()
+
+	payload := buildWorkspacePayload(plan)
+	if payload.HighImpactUserThreshold == nil || *payload.HighImpactUserThreshold != 100 {
+		t.Fatalf("expected the configured high impact threshold, got %v", payload.HighImpactUserThreshold)
+	}
+	if payload.MediumImpactUserThreshold != nil || payload.HighVelocityThreshold != nil {
+		t.Fatalf("expected unknown thresholds to be omitted, got %+v", payload)
+	}
+}